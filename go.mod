@@ -5,5 +5,10 @@ go 1.12
 require (
 	github.com/AsynkronIT/protoactor-go v0.0.0-20190429152931-21e2d03dcae5
 	github.com/gogo/protobuf v1.2.1
+	github.com/peterh/liner v1.1.0
+	github.com/prometheus/client_golang v0.9.4
+	github.com/sirupsen/logrus v1.4.2
 	github.com/urfave/cli v1.20.0
+	google.golang.org/grpc v1.18.0
+	gopkg.in/yaml.v2 v2.2.2
 )
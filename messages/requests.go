@@ -0,0 +1,245 @@
+package messages
+
+import (
+	"reflect"
+	"time"
+)
+
+// Requests carrying a deadline as unix time in milliseconds. Implemented by all requests in tree.proto.
+type Deadlined interface {
+	GetDeadline() int64
+	SetDeadline(deadline int64)
+}
+
+// Messages carrying the id of the request they belong to. Implemented by all requests in tree.proto and the
+// messages passed on through a tree on their behalf.
+type Identifiable interface {
+	GetRequestId() string
+	SetRequestId(id string)
+}
+
+// Returns t as unix time in milliseconds like the deadlines.
+func MillisOf(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// Returns whether the deadline of the message has passed. Messages without deadline never expire.
+func PastDeadline(message interface{}) bool {
+	msg, ok := message.(Deadlined)
+	return ok && msg.GetDeadline() != 0 && MillisOf(time.Now()) >= msg.GetDeadline()
+}
+
+// Returns the time left until the deadline of the message or fallback if it has none.
+func TimeLeft(message interface{}, fallback time.Duration) time.Duration {
+	msg, ok := message.(Deadlined)
+	if !ok || msg.GetDeadline() == 0 {
+		return fallback
+	}
+	left := time.Until(time.Unix(0, msg.GetDeadline()*int64(time.Millisecond)))
+	if left <= 0 {
+		// RequestFuture never times out without a positive timeout.
+		return time.Millisecond
+	}
+	return left
+}
+
+// Returns the request id of the message or an empty string if it has none.
+func RequestIDOf(message interface{}) string {
+	if msg, ok := message.(Identifiable); ok {
+		return msg.GetRequestId()
+	}
+	return ""
+}
+
+// Returns the name of the type of a message without package, e.g. InsertRequest, or none for nil.
+func TypeOf(message interface{}) string {
+	if message == nil {
+		return "none"
+	}
+	t := reflect.TypeOf(message)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// Setters matching the generated getters, used to fill in deadlines and request ids of requests without them.
+func (m *CreateTreeRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *TreeInfoRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *CloneTreeRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *SetTreeExpiryRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *DeleteTreeRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *InsertRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *InsertBatchRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *DeleteRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *SearchRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *TraverseRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *RangeRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *SnapshotRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *ReleaseSnapshotRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *BeginTxRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *StageTxRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *CommitTxRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *AbortTxRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *WatchRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *TraceRequest) SetDeadline(deadline int64) {
+	m.Deadline = deadline
+}
+
+func (m *CreateTreeRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *TreeInfoRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *CloneTreeRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *SetTreeExpiryRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *DeleteTreeRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *InsertRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *InsertBatchRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *DeleteRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *SearchRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *TraverseRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *RangeRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *SnapshotRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *ReleaseSnapshotRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *ActiveSnapshots) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *PurgeExpired) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *BeginTxRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *StageTxRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *CommitTxRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *AbortTxRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *TxPrepare) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *TxVersionRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *TxCommit) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *TxAbort) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *SizeDelta) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *WatchRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
+
+func (m *TraceRequest) SetRequestId(id string) {
+	m.RequestId = id
+}
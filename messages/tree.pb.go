@@ -4,11 +4,14 @@
 package messages
 
 import (
+	context "context"
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
 	io "io"
 	math "math"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 )
 
@@ -23,21 +26,71 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
-type NoSuchTreeError struct {
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+type TxOperation_Type int32
+
+const (
+	INSERT TxOperation_Type = 0
+	DELETE TxOperation_Type = 1
+	UPDATE TxOperation_Type = 2
+)
+
+var TxOperation_Type_name = map[int32]string{
+	0: "INSERT",
+	1: "DELETE",
+	2: "UPDATE",
 }
 
-func (m *NoSuchTreeError) Reset()      { *m = NoSuchTreeError{} }
-func (*NoSuchTreeError) ProtoMessage() {}
-func (*NoSuchTreeError) Descriptor() ([]byte, []int) {
+var TxOperation_Type_value = map[string]int32{
+	"INSERT": 0,
+	"DELETE": 1,
+	"UPDATE": 2,
+}
+
+func (TxOperation_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{48, 0}
+}
+
+type WatchEvent_Type int32
+
+const (
+	INSERTED WatchEvent_Type = 0
+	UPDATED  WatchEvent_Type = 1
+	DELETED  WatchEvent_Type = 2
+)
+
+var WatchEvent_Type_name = map[int32]string{
+	0: "INSERTED",
+	1: "UPDATED",
+	2: "DELETED",
+}
+
+var WatchEvent_Type_value = map[string]int32{
+	"INSERTED": 0,
+	"UPDATED":  1,
+	"DELETED":  2,
+}
+
+func (WatchEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{68, 0}
+}
+
+// Components for other Messages
+type Credentials struct {
+	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *Credentials) Reset()      { *m = Credentials{} }
+func (*Credentials) ProtoMessage() {}
+func (*Credentials) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{0}
 }
-func (m *NoSuchTreeError) XXX_Unmarshal(b []byte) error {
+func (m *Credentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NoSuchTreeError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Credentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NoSuchTreeError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Credentials.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -47,40 +100,50 @@ func (m *NoSuchTreeError) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *NoSuchTreeError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NoSuchTreeError.Merge(m, src)
+func (m *Credentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Credentials.Merge(m, src)
 }
-func (m *NoSuchTreeError) XXX_Size() int {
+func (m *Credentials) XXX_Size() int {
 	return m.Size()
 }
-func (m *NoSuchTreeError) XXX_DiscardUnknown() {
-	xxx_messageInfo_NoSuchTreeError.DiscardUnknown(m)
+func (m *Credentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_Credentials.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NoSuchTreeError proto.InternalMessageInfo
+var xxx_messageInfo_Credentials proto.InternalMessageInfo
 
-func (m *NoSuchTreeError) GetId() int64 {
+func (m *Credentials) GetId() int64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-type InvalidTokenError struct {
-	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+func (m *Credentials) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-func (m *InvalidTokenError) Reset()      { *m = InvalidTokenError{} }
-func (*InvalidTokenError) ProtoMessage() {}
-func (*InvalidTokenError) Descriptor() ([]byte, []int) {
+type Item struct {
+	Key   int64  `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Unix time in milliseconds after which the item is gone, 0 if it never expires
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *Item) Reset()      { *m = Item{} }
+func (*Item) ProtoMessage() {}
+func (*Item) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{1}
 }
-func (m *InvalidTokenError) XXX_Unmarshal(b []byte) error {
+func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InvalidTokenError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InvalidTokenError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Item.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -90,40 +153,59 @@ func (m *InvalidTokenError) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *InvalidTokenError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvalidTokenError.Merge(m, src)
+func (m *Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Item.Merge(m, src)
 }
-func (m *InvalidTokenError) XXX_Size() int {
+func (m *Item) XXX_Size() int {
 	return m.Size()
 }
-func (m *InvalidTokenError) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvalidTokenError.DiscardUnknown(m)
+func (m *Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Item.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InvalidTokenError proto.InternalMessageInfo
+var xxx_messageInfo_Item proto.InternalMessageInfo
 
-func (m *InvalidTokenError) GetCredentials() *Credentials {
+func (m *Item) GetKey() int64 {
 	if m != nil {
-		return m.Credentials
+		return m.Key
 	}
-	return nil
+	return 0
 }
 
-type NoSuchKeyError struct {
-	Key int64 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *Item) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *NoSuchKeyError) Reset()      { *m = NoSuchKeyError{} }
-func (*NoSuchKeyError) ProtoMessage() {}
-func (*NoSuchKeyError) Descriptor() ([]byte, []int) {
+func (m *Item) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// Version of an item. Deleted versions hide older ones
+type Version struct {
+	Version int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Deleted bool   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Unix time in milliseconds after which the value is gone, 0 if it never expires
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *Version) Reset()      { *m = Version{} }
+func (*Version) ProtoMessage() {}
+func (*Version) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{2}
 }
-func (m *NoSuchKeyError) XXX_Unmarshal(b []byte) error {
+func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NoSuchKeyError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NoSuchKeyError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Version.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -133,40 +215,62 @@ func (m *NoSuchKeyError) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *NoSuchKeyError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NoSuchKeyError.Merge(m, src)
+func (m *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(m, src)
 }
-func (m *NoSuchKeyError) XXX_Size() int {
+func (m *Version) XXX_Size() int {
 	return m.Size()
 }
-func (m *NoSuchKeyError) XXX_DiscardUnknown() {
-	xxx_messageInfo_NoSuchKeyError.DiscardUnknown(m)
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NoSuchKeyError proto.InternalMessageInfo
+var xxx_messageInfo_Version proto.InternalMessageInfo
 
-func (m *NoSuchKeyError) GetKey() int64 {
+func (m *Version) GetVersion() int64 {
 	if m != nil {
-		return m.Key
+		return m.Version
 	}
 	return 0
 }
 
-type KeyAlreadyExistsError struct {
-	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+func (m *Version) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *KeyAlreadyExistsError) Reset()      { *m = KeyAlreadyExistsError{} }
-func (*KeyAlreadyExistsError) ProtoMessage() {}
-func (*KeyAlreadyExistsError) Descriptor() ([]byte, []int) {
+func (m *Version) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *Version) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type VersionedItem struct {
+	Key      int64      `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Versions []*Version `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+}
+
+func (m *VersionedItem) Reset()      { *m = VersionedItem{} }
+func (*VersionedItem) ProtoMessage() {}
+func (*VersionedItem) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{3}
 }
-func (m *KeyAlreadyExistsError) XXX_Unmarshal(b []byte) error {
+func (m *VersionedItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KeyAlreadyExistsError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VersionedItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_KeyAlreadyExistsError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VersionedItem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -176,41 +280,50 @@ func (m *KeyAlreadyExistsError) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *KeyAlreadyExistsError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KeyAlreadyExistsError.Merge(m, src)
+func (m *VersionedItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionedItem.Merge(m, src)
 }
-func (m *KeyAlreadyExistsError) XXX_Size() int {
+func (m *VersionedItem) XXX_Size() int {
 	return m.Size()
 }
-func (m *KeyAlreadyExistsError) XXX_DiscardUnknown() {
-	xxx_messageInfo_KeyAlreadyExistsError.DiscardUnknown(m)
+func (m *VersionedItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionedItem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KeyAlreadyExistsError proto.InternalMessageInfo
+var xxx_messageInfo_VersionedItem proto.InternalMessageInfo
 
-func (m *KeyAlreadyExistsError) GetItem() *Item {
+func (m *VersionedItem) GetKey() int64 {
 	if m != nil {
-		return m.Item
+		return m.Key
+	}
+	return 0
+}
+
+func (m *VersionedItem) GetVersions() []*Version {
+	if m != nil {
+		return m.Versions
 	}
 	return nil
 }
 
-type Credentials struct {
-	Id    int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+// Limits of a tree, 0 for no limit. Bytes are the lengths of the latest values
+type Quota struct {
+	MaxItems      int64 `protobuf:"varint,1,opt,name=maxItems,proto3" json:"maxItems,omitempty"`
+	MaxValueBytes int64 `protobuf:"varint,2,opt,name=maxValueBytes,proto3" json:"maxValueBytes,omitempty"`
+	MaxTotalBytes int64 `protobuf:"varint,3,opt,name=maxTotalBytes,proto3" json:"maxTotalBytes,omitempty"`
 }
 
-func (m *Credentials) Reset()      { *m = Credentials{} }
-func (*Credentials) ProtoMessage() {}
-func (*Credentials) Descriptor() ([]byte, []int) {
+func (m *Quota) Reset()      { *m = Quota{} }
+func (*Quota) ProtoMessage() {}
+func (*Quota) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{4}
 }
-func (m *Credentials) XXX_Unmarshal(b []byte) error {
+func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Credentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Credentials.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -220,48 +333,56 @@ func (m *Credentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Credentials) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Credentials.Merge(m, src)
+func (m *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(m, src)
 }
-func (m *Credentials) XXX_Size() int {
+func (m *Quota) XXX_Size() int {
 	return m.Size()
 }
-func (m *Credentials) XXX_DiscardUnknown() {
-	xxx_messageInfo_Credentials.DiscardUnknown(m)
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Credentials proto.InternalMessageInfo
+var xxx_messageInfo_Quota proto.InternalMessageInfo
 
-func (m *Credentials) GetId() int64 {
+func (m *Quota) GetMaxItems() int64 {
 	if m != nil {
-		return m.Id
+		return m.MaxItems
 	}
 	return 0
 }
 
-func (m *Credentials) GetToken() string {
+func (m *Quota) GetMaxValueBytes() int64 {
 	if m != nil {
-		return m.Token
+		return m.MaxValueBytes
 	}
-	return ""
+	return 0
 }
 
-type Item struct {
-	Key   int64  `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *Quota) GetMaxTotalBytes() int64 {
+	if m != nil {
+		return m.MaxTotalBytes
+	}
+	return 0
 }
 
-func (m *Item) Reset()      { *m = Item{} }
-func (*Item) ProtoMessage() {}
-func (*Item) Descriptor() ([]byte, []int) {
+// Snapshot to read from. The version is filled in by the treeservice
+type Snapshot struct {
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *Snapshot) Reset()      { *m = Snapshot{} }
+func (*Snapshot) ProtoMessage() {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{5}
 }
-func (m *Item) XXX_Unmarshal(b []byte) error {
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Item.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -271,47 +392,48 @@ func (m *Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Item) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Item.Merge(m, src)
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
 }
-func (m *Item) XXX_Size() int {
+func (m *Snapshot) XXX_Size() int {
 	return m.Size()
 }
-func (m *Item) XXX_DiscardUnknown() {
-	xxx_messageInfo_Item.DiscardUnknown(m)
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Item proto.InternalMessageInfo
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
 
-func (m *Item) GetKey() int64 {
+func (m *Snapshot) GetId() int64 {
 	if m != nil {
-		return m.Key
+		return m.Id
 	}
 	return 0
 }
 
-func (m *Item) GetValue() string {
+func (m *Snapshot) GetVersion() int64 {
 	if m != nil {
-		return m.Value
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-type CreateTreeRequest struct {
-	MaxSize int64 `protobuf:"varint,1,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+// Error messages
+type NoSuchTreeError struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *CreateTreeRequest) Reset()      { *m = CreateTreeRequest{} }
-func (*CreateTreeRequest) ProtoMessage() {}
-func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
+func (m *NoSuchTreeError) Reset()      { *m = NoSuchTreeError{} }
+func (*NoSuchTreeError) ProtoMessage() {}
+func (*NoSuchTreeError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{6}
 }
-func (m *CreateTreeRequest) XXX_Unmarshal(b []byte) error {
+func (m *NoSuchTreeError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NoSuchTreeError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateTreeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NoSuchTreeError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -321,40 +443,40 @@ func (m *CreateTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *CreateTreeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTreeRequest.Merge(m, src)
+func (m *NoSuchTreeError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoSuchTreeError.Merge(m, src)
 }
-func (m *CreateTreeRequest) XXX_Size() int {
+func (m *NoSuchTreeError) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateTreeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTreeRequest.DiscardUnknown(m)
+func (m *NoSuchTreeError) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoSuchTreeError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTreeRequest proto.InternalMessageInfo
+var xxx_messageInfo_NoSuchTreeError proto.InternalMessageInfo
 
-func (m *CreateTreeRequest) GetMaxSize() int64 {
+func (m *NoSuchTreeError) GetId() int64 {
 	if m != nil {
-		return m.MaxSize
+		return m.Id
 	}
 	return 0
 }
 
-type CreateTreeResponse struct {
+type InvalidTokenError struct {
 	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
 }
 
-func (m *CreateTreeResponse) Reset()      { *m = CreateTreeResponse{} }
-func (*CreateTreeResponse) ProtoMessage() {}
-func (*CreateTreeResponse) Descriptor() ([]byte, []int) {
+func (m *InvalidTokenError) Reset()      { *m = InvalidTokenError{} }
+func (*InvalidTokenError) ProtoMessage() {}
+func (*InvalidTokenError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{7}
 }
-func (m *CreateTreeResponse) XXX_Unmarshal(b []byte) error {
+func (m *InvalidTokenError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InvalidTokenError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateTreeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InvalidTokenError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -364,40 +486,40 @@ func (m *CreateTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CreateTreeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTreeResponse.Merge(m, src)
+func (m *InvalidTokenError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidTokenError.Merge(m, src)
 }
-func (m *CreateTreeResponse) XXX_Size() int {
+func (m *InvalidTokenError) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateTreeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTreeResponse.DiscardUnknown(m)
+func (m *InvalidTokenError) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidTokenError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTreeResponse proto.InternalMessageInfo
+var xxx_messageInfo_InvalidTokenError proto.InternalMessageInfo
 
-func (m *CreateTreeResponse) GetCredentials() *Credentials {
+func (m *InvalidTokenError) GetCredentials() *Credentials {
 	if m != nil {
 		return m.Credentials
 	}
 	return nil
 }
 
-type DeleteTreeRequest struct {
-	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+type NoSuchKeyError struct {
+	Key int64 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *DeleteTreeRequest) Reset()      { *m = DeleteTreeRequest{} }
-func (*DeleteTreeRequest) ProtoMessage() {}
-func (*DeleteTreeRequest) Descriptor() ([]byte, []int) {
+func (m *NoSuchKeyError) Reset()      { *m = NoSuchKeyError{} }
+func (*NoSuchKeyError) ProtoMessage() {}
+func (*NoSuchKeyError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{8}
 }
-func (m *DeleteTreeRequest) XXX_Unmarshal(b []byte) error {
+func (m *NoSuchKeyError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NoSuchKeyError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteTreeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NoSuchKeyError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -407,40 +529,40 @@ func (m *DeleteTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DeleteTreeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTreeRequest.Merge(m, src)
+func (m *NoSuchKeyError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoSuchKeyError.Merge(m, src)
 }
-func (m *DeleteTreeRequest) XXX_Size() int {
+func (m *NoSuchKeyError) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteTreeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTreeRequest.DiscardUnknown(m)
+func (m *NoSuchKeyError) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoSuchKeyError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTreeRequest proto.InternalMessageInfo
+var xxx_messageInfo_NoSuchKeyError proto.InternalMessageInfo
 
-func (m *DeleteTreeRequest) GetCredentials() *Credentials {
+func (m *NoSuchKeyError) GetKey() int64 {
 	if m != nil {
-		return m.Credentials
+		return m.Key
 	}
-	return nil
+	return 0
 }
 
-type DeleteTreeResponse struct {
-	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+type KeyAlreadyExistsError struct {
+	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (m *DeleteTreeResponse) Reset()      { *m = DeleteTreeResponse{} }
-func (*DeleteTreeResponse) ProtoMessage() {}
-func (*DeleteTreeResponse) Descriptor() ([]byte, []int) {
+func (m *KeyAlreadyExistsError) Reset()      { *m = KeyAlreadyExistsError{} }
+func (*KeyAlreadyExistsError) ProtoMessage() {}
+func (*KeyAlreadyExistsError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{9}
 }
-func (m *DeleteTreeResponse) XXX_Unmarshal(b []byte) error {
+func (m *KeyAlreadyExistsError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KeyAlreadyExistsError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteTreeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KeyAlreadyExistsError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -450,41 +572,40 @@ func (m *DeleteTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DeleteTreeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTreeResponse.Merge(m, src)
+func (m *KeyAlreadyExistsError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAlreadyExistsError.Merge(m, src)
 }
-func (m *DeleteTreeResponse) XXX_Size() int {
+func (m *KeyAlreadyExistsError) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteTreeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTreeResponse.DiscardUnknown(m)
+func (m *KeyAlreadyExistsError) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAlreadyExistsError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTreeResponse proto.InternalMessageInfo
+var xxx_messageInfo_KeyAlreadyExistsError proto.InternalMessageInfo
 
-func (m *DeleteTreeResponse) GetCredentials() *Credentials {
+func (m *KeyAlreadyExistsError) GetItem() *Item {
 	if m != nil {
-		return m.Credentials
+		return m.Item
 	}
 	return nil
 }
 
-type InsertRequest struct {
-	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Item        *Item        `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+type NoSuchTxError struct {
+	TxId int64 `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
 }
 
-func (m *InsertRequest) Reset()      { *m = InsertRequest{} }
-func (*InsertRequest) ProtoMessage() {}
-func (*InsertRequest) Descriptor() ([]byte, []int) {
+func (m *NoSuchTxError) Reset()      { *m = NoSuchTxError{} }
+func (*NoSuchTxError) ProtoMessage() {}
+func (*NoSuchTxError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{10}
 }
-func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+func (m *NoSuchTxError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NoSuchTxError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NoSuchTxError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -494,47 +615,41 @@ func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *InsertRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertRequest.Merge(m, src)
+func (m *NoSuchTxError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoSuchTxError.Merge(m, src)
 }
-func (m *InsertRequest) XXX_Size() int {
+func (m *NoSuchTxError) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+func (m *NoSuchTxError) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoSuchTxError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
-
-func (m *InsertRequest) GetCredentials() *Credentials {
-	if m != nil {
-		return m.Credentials
-	}
-	return nil
-}
+var xxx_messageInfo_NoSuchTxError proto.InternalMessageInfo
 
-func (m *InsertRequest) GetItem() *Item {
+func (m *NoSuchTxError) GetTxId() int64 {
 	if m != nil {
-		return m.Item
+		return m.TxId
 	}
-	return nil
+	return 0
 }
 
-type InsertResponse struct {
-	Item *Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+type TxAbortedError struct {
+	TxId   int64  `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *InsertResponse) Reset()      { *m = InsertResponse{} }
-func (*InsertResponse) ProtoMessage() {}
-func (*InsertResponse) Descriptor() ([]byte, []int) {
+func (m *TxAbortedError) Reset()      { *m = TxAbortedError{} }
+func (*TxAbortedError) ProtoMessage() {}
+func (*TxAbortedError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{11}
 }
-func (m *InsertResponse) XXX_Unmarshal(b []byte) error {
+func (m *TxAbortedError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxAbortedError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InsertResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxAbortedError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -544,40 +659,47 @@ func (m *InsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *InsertResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertResponse.Merge(m, src)
+func (m *TxAbortedError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxAbortedError.Merge(m, src)
 }
-func (m *InsertResponse) XXX_Size() int {
+func (m *TxAbortedError) XXX_Size() int {
 	return m.Size()
 }
-func (m *InsertResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertResponse.DiscardUnknown(m)
+func (m *TxAbortedError) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxAbortedError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertResponse proto.InternalMessageInfo
+var xxx_messageInfo_TxAbortedError proto.InternalMessageInfo
 
-func (m *InsertResponse) GetItem() *Item {
+func (m *TxAbortedError) GetTxId() int64 {
 	if m != nil {
-		return m.Item
+		return m.TxId
 	}
-	return nil
+	return 0
 }
 
-type MultiInsert struct {
-	Items []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+func (m *TxAbortedError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MultiInsert) Reset()      { *m = MultiInsert{} }
-func (*MultiInsert) ProtoMessage() {}
-func (*MultiInsert) Descriptor() ([]byte, []int) {
+type NoSuchSnapshotError struct {
+	SnapshotId int64 `protobuf:"varint,1,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+}
+
+func (m *NoSuchSnapshotError) Reset()      { *m = NoSuchSnapshotError{} }
+func (*NoSuchSnapshotError) ProtoMessage() {}
+func (*NoSuchSnapshotError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{12}
 }
-func (m *MultiInsert) XXX_Unmarshal(b []byte) error {
+func (m *NoSuchSnapshotError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiInsert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NoSuchSnapshotError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiInsert.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NoSuchSnapshotError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -587,41 +709,41 @@ func (m *MultiInsert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MultiInsert) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiInsert.Merge(m, src)
+func (m *NoSuchSnapshotError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoSuchSnapshotError.Merge(m, src)
 }
-func (m *MultiInsert) XXX_Size() int {
+func (m *NoSuchSnapshotError) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiInsert) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiInsert.DiscardUnknown(m)
+func (m *NoSuchSnapshotError) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoSuchSnapshotError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiInsert proto.InternalMessageInfo
+var xxx_messageInfo_NoSuchSnapshotError proto.InternalMessageInfo
 
-func (m *MultiInsert) GetItems() []*Item {
+func (m *NoSuchSnapshotError) GetSnapshotId() int64 {
 	if m != nil {
-		return m.Items
+		return m.SnapshotId
 	}
-	return nil
+	return 0
 }
 
-type DeleteRequest struct {
-	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Key         int64        `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+type KeyLockedError struct {
+	Key  int64 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	TxId int64 `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
 }
 
-func (m *DeleteRequest) Reset()      { *m = DeleteRequest{} }
-func (*DeleteRequest) ProtoMessage() {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+func (m *KeyLockedError) Reset()      { *m = KeyLockedError{} }
+func (*KeyLockedError) ProtoMessage() {}
+func (*KeyLockedError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{13}
 }
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+func (m *KeyLockedError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KeyLockedError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KeyLockedError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -631,47 +753,47 @@ func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *KeyLockedError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyLockedError.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
+func (m *KeyLockedError) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *KeyLockedError) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyLockedError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_KeyLockedError proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetCredentials() *Credentials {
+func (m *KeyLockedError) GetKey() int64 {
 	if m != nil {
-		return m.Credentials
+		return m.Key
 	}
-	return nil
+	return 0
 }
 
-func (m *DeleteRequest) GetKey() int64 {
+func (m *KeyLockedError) GetTxId() int64 {
 	if m != nil {
-		return m.Key
+		return m.TxId
 	}
 	return 0
 }
 
-type DeleteResponse struct {
-	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+type DeadlineExceededError struct {
+	Deadline int64 `protobuf:"varint,1,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *DeleteResponse) Reset()      { *m = DeleteResponse{} }
-func (*DeleteResponse) ProtoMessage() {}
-func (*DeleteResponse) Descriptor() ([]byte, []int) {
+func (m *DeadlineExceededError) Reset()      { *m = DeadlineExceededError{} }
+func (*DeadlineExceededError) ProtoMessage() {}
+func (*DeadlineExceededError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{14}
 }
-func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+func (m *DeadlineExceededError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeadlineExceededError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeadlineExceededError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -681,41 +803,45 @@ func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DeleteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteResponse.Merge(m, src)
+func (m *DeadlineExceededError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlineExceededError.Merge(m, src)
 }
-func (m *DeleteResponse) XXX_Size() int {
+func (m *DeadlineExceededError) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+func (m *DeadlineExceededError) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlineExceededError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeadlineExceededError proto.InternalMessageInfo
 
-func (m *DeleteResponse) GetItem() *Item {
+func (m *DeadlineExceededError) GetDeadline() int64 {
 	if m != nil {
-		return m.Item
+		return m.Deadline
 	}
-	return nil
+	return 0
 }
 
-type SearchRequest struct {
-	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Key         int64        `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+// Limit is maxItems, maxValueBytes or maxTotalBytes. Requested is the value the request would have led to.
+// Scope is tree for the quota of the tree or treeservice for the limits of all trees together
+type QuotaExceededError struct {
+	Limit     string `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Max       int64  `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Requested int64  `protobuf:"varint,3,opt,name=requested,proto3" json:"requested,omitempty"`
+	Scope     string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
 }
 
-func (m *SearchRequest) Reset()      { *m = SearchRequest{} }
-func (*SearchRequest) ProtoMessage() {}
-func (*SearchRequest) Descriptor() ([]byte, []int) {
+func (m *QuotaExceededError) Reset()      { *m = QuotaExceededError{} }
+func (*QuotaExceededError) ProtoMessage() {}
+func (*QuotaExceededError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{15}
 }
-func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuotaExceededError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuotaExceededError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuotaExceededError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -725,47 +851,65 @@ func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *SearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchRequest.Merge(m, src)
+func (m *QuotaExceededError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaExceededError.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
+func (m *QuotaExceededError) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *QuotaExceededError) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaExceededError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuotaExceededError proto.InternalMessageInfo
 
-func (m *SearchRequest) GetCredentials() *Credentials {
+func (m *QuotaExceededError) GetLimit() string {
 	if m != nil {
-		return m.Credentials
+		return m.Limit
 	}
-	return nil
+	return ""
 }
 
-func (m *SearchRequest) GetKey() int64 {
+func (m *QuotaExceededError) GetMax() int64 {
 	if m != nil {
-		return m.Key
+		return m.Max
 	}
 	return 0
 }
 
-type SearchResponse struct {
-	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+func (m *QuotaExceededError) GetRequested() int64 {
+	if m != nil {
+		return m.Requested
+	}
+	return 0
 }
 
-func (m *SearchResponse) Reset()      { *m = SearchResponse{} }
-func (*SearchResponse) ProtoMessage() {}
-func (*SearchResponse) Descriptor() ([]byte, []int) {
+func (m *QuotaExceededError) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+// Scope is tree or client, key the id of the tree or the address of the client. RetryAfter is the time in milliseconds
+// until the request would be accepted
+type RateLimitedError struct {
+	Scope      string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Key        string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	RetryAfter int64  `protobuf:"varint,3,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
+}
+
+func (m *RateLimitedError) Reset()      { *m = RateLimitedError{} }
+func (*RateLimitedError) ProtoMessage() {}
+func (*RateLimitedError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{16}
 }
-func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+func (m *RateLimitedError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateLimitedError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateLimitedError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -775,40 +919,57 @@ func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *SearchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResponse.Merge(m, src)
+func (m *RateLimitedError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitedError.Merge(m, src)
 }
-func (m *SearchResponse) XXX_Size() int {
+func (m *RateLimitedError) XXX_Size() int {
 	return m.Size()
 }
-func (m *SearchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+func (m *RateLimitedError) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitedError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+var xxx_messageInfo_RateLimitedError proto.InternalMessageInfo
 
-func (m *SearchResponse) GetItem() *Item {
+func (m *RateLimitedError) GetScope() string {
 	if m != nil {
-		return m.Item
+		return m.Scope
 	}
-	return nil
+	return ""
 }
 
-type TraverseRequest struct {
-	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+func (m *RateLimitedError) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *TraverseRequest) Reset()      { *m = TraverseRequest{} }
-func (*TraverseRequest) ProtoMessage() {}
-func (*TraverseRequest) Descriptor() ([]byte, []int) {
+func (m *RateLimitedError) GetRetryAfter() int64 {
+	if m != nil {
+		return m.RetryAfter
+	}
+	return 0
+}
+
+// Node is the id of the node actor whose mailbox held capacity messages already. The request wasn't handled
+// by that node, but parts of an InsertBatchRequest may have been inserted by other leafs
+type OverloadedError struct {
+	Node     string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Capacity int64  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (m *OverloadedError) Reset()      { *m = OverloadedError{} }
+func (*OverloadedError) ProtoMessage() {}
+func (*OverloadedError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{17}
 }
-func (m *TraverseRequest) XXX_Unmarshal(b []byte) error {
+func (m *OverloadedError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TraverseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OverloadedError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TraverseRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OverloadedError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -818,40 +979,47 @@ func (m *TraverseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *TraverseRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TraverseRequest.Merge(m, src)
+func (m *OverloadedError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverloadedError.Merge(m, src)
 }
-func (m *TraverseRequest) XXX_Size() int {
+func (m *OverloadedError) XXX_Size() int {
 	return m.Size()
 }
-func (m *TraverseRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TraverseRequest.DiscardUnknown(m)
+func (m *OverloadedError) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverloadedError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TraverseRequest proto.InternalMessageInfo
+var xxx_messageInfo_OverloadedError proto.InternalMessageInfo
 
-func (m *TraverseRequest) GetCredentials() *Credentials {
+func (m *OverloadedError) GetNode() string {
 	if m != nil {
-		return m.Credentials
+		return m.Node
 	}
-	return nil
+	return ""
 }
 
-type TraverseResponse struct {
-	Items []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+func (m *OverloadedError) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
 }
 
-func (m *TraverseResponse) Reset()      { *m = TraverseResponse{} }
-func (*TraverseResponse) ProtoMessage() {}
-func (*TraverseResponse) Descriptor() ([]byte, []int) {
+// The treeservice is shutting down and doesn't accept requests anymore, the request wasn't applied
+type ShuttingDownError struct {
+}
+
+func (m *ShuttingDownError) Reset()      { *m = ShuttingDownError{} }
+func (*ShuttingDownError) ProtoMessage() {}
+func (*ShuttingDownError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_cb3889276909882a, []int{18}
 }
-func (m *TraverseResponse) XXX_Unmarshal(b []byte) error {
+func (m *ShuttingDownError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TraverseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ShuttingDownError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TraverseResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ShuttingDownError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -861,1829 +1029,19620 @@ func (m *TraverseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *TraverseResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TraverseResponse.Merge(m, src)
+func (m *ShuttingDownError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShuttingDownError.Merge(m, src)
 }
-func (m *TraverseResponse) XXX_Size() int {
+func (m *ShuttingDownError) XXX_Size() int {
 	return m.Size()
 }
-func (m *TraverseResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TraverseResponse.DiscardUnknown(m)
+func (m *ShuttingDownError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShuttingDownError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TraverseResponse proto.InternalMessageInfo
+var xxx_messageInfo_ShuttingDownError proto.InternalMessageInfo
 
-func (m *TraverseResponse) GetItems() []*Item {
-	if m != nil {
-		return m.Items
-	}
-	return nil
+// The request lacks a field the treeservice needs to handle it, e.g. the credentials or the item of an insert
+type InvalidRequestError struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*NoSuchTreeError)(nil), "messages.NoSuchTreeError")
-	proto.RegisterType((*InvalidTokenError)(nil), "messages.InvalidTokenError")
-	proto.RegisterType((*NoSuchKeyError)(nil), "messages.NoSuchKeyError")
-	proto.RegisterType((*KeyAlreadyExistsError)(nil), "messages.KeyAlreadyExistsError")
-	proto.RegisterType((*Credentials)(nil), "messages.Credentials")
-	proto.RegisterType((*Item)(nil), "messages.Item")
-	proto.RegisterType((*CreateTreeRequest)(nil), "messages.CreateTreeRequest")
-	proto.RegisterType((*CreateTreeResponse)(nil), "messages.CreateTreeResponse")
-	proto.RegisterType((*DeleteTreeRequest)(nil), "messages.DeleteTreeRequest")
-	proto.RegisterType((*DeleteTreeResponse)(nil), "messages.DeleteTreeResponse")
-	proto.RegisterType((*InsertRequest)(nil), "messages.InsertRequest")
-	proto.RegisterType((*InsertResponse)(nil), "messages.InsertResponse")
-	proto.RegisterType((*MultiInsert)(nil), "messages.MultiInsert")
-	proto.RegisterType((*DeleteRequest)(nil), "messages.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "messages.DeleteResponse")
-	proto.RegisterType((*SearchRequest)(nil), "messages.SearchRequest")
-	proto.RegisterType((*SearchResponse)(nil), "messages.SearchResponse")
-	proto.RegisterType((*TraverseRequest)(nil), "messages.TraverseRequest")
-	proto.RegisterType((*TraverseResponse)(nil), "messages.TraverseResponse")
+func (m *InvalidRequestError) Reset()      { *m = InvalidRequestError{} }
+func (*InvalidRequestError) ProtoMessage() {}
+func (*InvalidRequestError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{19}
+}
+func (m *InvalidRequestError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidRequestError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidRequestError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvalidRequestError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidRequestError.Merge(m, src)
+}
+func (m *InvalidRequestError) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidRequestError) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidRequestError.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("tree.proto", fileDescriptor_cb3889276909882a) }
+var xxx_messageInfo_InvalidRequestError proto.InternalMessageInfo
 
-var fileDescriptor_cb3889276909882a = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x39, 0x2d, 0x7f, 0x5e, 0x14, 0xb7, 0xb1, 0xa8, 0x94, 0xe9, 0x54, 0x4e, 0x0c, 0x5d,
-	0xc8, 0x40, 0x22, 0x81, 0xc4, 0x04, 0xa5, 0x43, 0x68, 0xc3, 0xe0, 0x64, 0xea, 0x76, 0xc4, 0x4f,
-	0xf4, 0x54, 0xc7, 0x2e, 0x77, 0xe7, 0xa8, 0x66, 0xe2, 0x23, 0xf0, 0x31, 0xf8, 0x28, 0x8c, 0x19,
-	0x3b, 0x12, 0x67, 0x61, 0xec, 0x47, 0x40, 0xf6, 0xd9, 0xd8, 0x14, 0x54, 0x95, 0x58, 0x6c, 0x7e,
-	0x7e, 0xbf, 0xf7, 0xfb, 0xfd, 0xde, 0x1f, 0x1d, 0x80, 0x96, 0x88, 0xfd, 0x0b, 0x19, 0xe9, 0xc8,
-	0x7d, 0x30, 0x47, 0xa5, 0xf8, 0x07, 0x54, 0xec, 0x31, 0xec, 0xbc, 0x8b, 0x26, 0xf1, 0xec, 0x6c,
-	0x2a, 0x11, 0x8f, 0xa4, 0x8c, 0xa4, 0xeb, 0x80, 0x2d, 0xfc, 0x1e, 0xd9, 0x27, 0x07, 0x2d, 0xcf,
-	0x16, 0x3e, 0x3b, 0x81, 0xee, 0x28, 0x5c, 0xf0, 0x40, 0xf8, 0xd3, 0xe8, 0x1c, 0x43, 0x03, 0x7a,
-	0x0e, 0xed, 0x99, 0x44, 0x1f, 0x43, 0x2d, 0x78, 0xa0, 0x72, 0x74, 0xfb, 0xd9, 0x5e, 0xbf, 0xe4,
-	0xed, 0x1f, 0x56, 0x49, 0xaf, 0x8e, 0x64, 0x0c, 0x1c, 0x23, 0x78, 0x8c, 0x89, 0xa1, 0xda, 0x85,
-	0xd6, 0x39, 0x26, 0x85, 0x60, 0xf6, 0xc9, 0x5e, 0xc2, 0xde, 0x31, 0x26, 0xaf, 0x02, 0x89, 0xdc,
-	0x4f, 0x8e, 0x2e, 0x85, 0xd2, 0xca, 0x40, 0x19, 0x6c, 0x09, 0x8d, 0xf3, 0x42, 0xce, 0xa9, 0xe4,
-	0x46, 0x1a, 0xe7, 0x5e, 0x9e, 0x63, 0x03, 0x68, 0xd7, 0xc4, 0x6f, 0x76, 0xe3, 0x3e, 0x82, 0x6d,
-	0x9d, 0xb5, 0xd1, 0xb3, 0xf7, 0xc9, 0xc1, 0x43, 0xcf, 0x04, 0xac, 0x0f, 0x5b, 0x19, 0xc5, 0x9f,
-	0x5e, 0x32, 0xfc, 0x82, 0x07, 0x31, 0x96, 0xf8, 0x3c, 0x60, 0x4f, 0xa1, 0x7b, 0x28, 0x91, 0x6b,
-	0xcc, 0xc6, 0xe6, 0xe1, 0xc7, 0x18, 0x95, 0x76, 0x7b, 0x70, 0x7f, 0xce, 0x2f, 0x27, 0xe2, 0x13,
-	0x16, 0x04, 0x65, 0xc8, 0xc6, 0xe0, 0xd6, 0xe1, 0xea, 0x22, 0x0a, 0x15, 0x6e, 0x3e, 0xc3, 0x13,
-	0xe8, 0xbe, 0xc1, 0x00, 0x7f, 0x57, 0xdf, 0x98, 0x6d, 0x0c, 0x6e, 0x9d, 0xad, 0xa9, 0xb9, 0x00,
-	0x3a, 0xa3, 0x50, 0xa1, 0xd4, 0x4d, 0x8d, 0xfd, 0xda, 0xb6, 0x7d, 0xcb, 0xb6, 0x87, 0xe0, 0x94,
-	0x6a, 0x85, 0xf1, 0xbb, 0x54, 0x0d, 0xa0, 0x3d, 0x8e, 0x03, 0x2d, 0x4c, 0xa9, 0xfb, 0x04, 0xb6,
-	0xb3, 0xdf, 0x99, 0xb7, 0xd6, 0x5f, 0x6a, 0x4c, 0x92, 0x9d, 0x42, 0xc7, 0xcc, 0xa9, 0x71, 0x63,
-	0xc5, 0x95, 0xd9, 0xd5, 0xc5, 0x0f, 0xc1, 0x29, 0xb9, 0x6f, 0xb4, 0x71, 0xdb, 0xa9, 0x9f, 0x42,
-	0x67, 0x82, 0x5c, 0xce, 0xce, 0xfe, 0x8f, 0xa3, 0x92, 0xfb, 0x1f, 0x1c, 0xbd, 0x85, 0x9d, 0xa9,
-	0xe4, 0x0b, 0x94, 0xaa, 0xf9, 0x5d, 0xbe, 0x80, 0xdd, 0x8a, 0xab, 0xf0, 0x70, 0xa7, 0x4d, 0xbd,
-	0x1e, 0x2e, 0x57, 0xd4, 0xba, 0x5a, 0x51, 0xeb, 0x7a, 0x45, 0xc9, 0xe7, 0x94, 0x92, 0xaf, 0x29,
-	0x25, 0xdf, 0x52, 0x4a, 0x96, 0x29, 0x25, 0xdf, 0x53, 0x4a, 0x7e, 0xa4, 0xd4, 0xba, 0x4e, 0x29,
-	0xf9, 0xb2, 0xa6, 0xd6, 0x72, 0x4d, 0xad, 0xab, 0x35, 0xb5, 0xde, 0xdf, 0xcb, 0xdf, 0xc6, 0xc1,
-	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x66, 0xfc, 0xd3, 0x29, 0x05, 0x00, 0x00,
+func (m *InvalidRequestError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (this *NoSuchTreeError) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+// Create tree
+type CreateTreeRequest struct {
+	MaxSize int64 `protobuf:"varint,1,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	// Tree is deleted after ttl milliseconds, 0 for the default of the treeservice
+	Ttl int64 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Tree is deleted after idleTimeout milliseconds without access, 0 for the default of the treeservice
+	IdleTimeout int64  `protobuf:"varint,3,opt,name=idleTimeout,proto3" json:"idleTimeout,omitempty"`
+	RequestId   string `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Limits below those of the treeservice, unset or 0 for its limits
+	Quota *Quota `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
+}
 
-	that1, ok := that.(*NoSuchTreeError)
-	if !ok {
-		that2, ok := that.(NoSuchTreeError)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *CreateTreeRequest) Reset()      { *m = CreateTreeRequest{} }
+func (*CreateTreeRequest) ProtoMessage() {}
+func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{20}
+}
+func (m *CreateTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
+}
+func (m *CreateTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTreeRequest.Merge(m, src)
+}
+func (m *CreateTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTreeRequest proto.InternalMessageInfo
+
+func (m *CreateTreeRequest) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
 	}
-	return true
+	return 0
 }
-func (this *InvalidTokenError) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateTreeRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
+	return 0
+}
 
-	that1, ok := that.(*InvalidTokenError)
-	if !ok {
-		that2, ok := that.(InvalidTokenError)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *CreateTreeRequest) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *CreateTreeRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
+	return ""
+}
+
+func (m *CreateTreeRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return true
+	return 0
 }
-func (this *NoSuchKeyError) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateTreeRequest) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
 	}
+	return nil
+}
 
-	that1, ok := that.(*NoSuchKeyError)
-	if !ok {
-		that2, ok := that.(NoSuchKeyError)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CreateTreeResponse struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (m *CreateTreeResponse) Reset()      { *m = CreateTreeResponse{} }
+func (*CreateTreeResponse) ProtoMessage() {}
+func (*CreateTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{21}
+}
+func (m *CreateTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	return true
 }
-func (this *KeyAlreadyExistsError) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CreateTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTreeResponse.Merge(m, src)
+}
+func (m *CreateTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTreeResponse proto.InternalMessageInfo
+
+func (m *CreateTreeResponse) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
+	return nil
+}
 
-	that1, ok := that.(*KeyAlreadyExistsError)
-	if !ok {
-		that2, ok := that.(KeyAlreadyExistsError)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Information about tree
+type TreeInfoRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	RequestId   string       `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *TreeInfoRequest) Reset()      { *m = TreeInfoRequest{} }
+func (*TreeInfoRequest) ProtoMessage() {}
+func (*TreeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{22}
+}
+func (m *TreeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreeInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Item.Equal(that1.Item) {
-		return false
-	}
-	return true
 }
-func (this *Credentials) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *TreeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeInfoRequest.Merge(m, src)
+}
+func (m *TreeInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeInfoRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Credentials)
-	if !ok {
-		that2, ok := that.(Credentials)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Token != that1.Token {
-		return false
+var xxx_messageInfo_TreeInfoRequest proto.InternalMessageInfo
+
+func (m *TreeInfoRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return true
+	return nil
 }
-func (this *Item) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Item)
-	if !ok {
-		that2, ok := that.(Item)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
+func (m *TreeInfoRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return true
+	return ""
 }
-func (this *CreateTreeRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TreeInfoRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
+	return 0
+}
 
-	that1, ok := that.(*CreateTreeRequest)
-	if !ok {
-		that2, ok := that.(CreateTreeRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type TreeInfoResponse struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	MaxSize     int64        `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	// Unix time in milliseconds, 0 if the tree never expires
+	ExpiresAt   int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	IdleTimeout int64  `protobuf:"varint,4,opt,name=idleTimeout,proto3" json:"idleTimeout,omitempty"`
+	Quota       *Quota `protobuf:"bytes,5,opt,name=quota,proto3" json:"quota,omitempty"`
+	// Number of items and total length of their values
+	Items int64 `protobuf:"varint,6,opt,name=items,proto3" json:"items,omitempty"`
+	Bytes int64 `protobuf:"varint,7,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (m *TreeInfoResponse) Reset()      { *m = TreeInfoResponse{} }
+func (*TreeInfoResponse) ProtoMessage() {}
+func (*TreeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{23}
+}
+func (m *TreeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *TreeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeInfoResponse.Merge(m, src)
+}
+func (m *TreeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeInfoResponse proto.InternalMessageInfo
+
+func (m *TreeInfoResponse) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	if this.MaxSize != that1.MaxSize {
-		return false
+	return nil
+}
+
+func (m *TreeInfoResponse) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
 	}
-	return true
+	return 0
 }
-func (this *CreateTreeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TreeInfoResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
+	return 0
+}
 
-	that1, ok := that.(*CreateTreeResponse)
-	if !ok {
-		that2, ok := that.(CreateTreeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *TreeInfoResponse) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *TreeInfoResponse) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
 	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
+	return nil
+}
+
+func (m *TreeInfoResponse) GetItems() int64 {
+	if m != nil {
+		return m.Items
 	}
-	return true
+	return 0
 }
-func (this *DeleteTreeRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TreeInfoResponse) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
 	}
+	return 0
+}
 
-	that1, ok := that.(*DeleteTreeRequest)
-	if !ok {
-		that2, ok := that.(DeleteTreeRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Clone tree
+type CloneTreeRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// Lifetime of the clone like in CreateTreeRequest
+	Ttl         int64  `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	IdleTimeout int64  `protobuf:"varint,3,opt,name=idleTimeout,proto3" json:"idleTimeout,omitempty"`
+	RequestId   string `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64  `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *CloneTreeRequest) Reset()      { *m = CloneTreeRequest{} }
+func (*CloneTreeRequest) ProtoMessage() {}
+func (*CloneTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{24}
+}
+func (m *CloneTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloneTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CloneTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
-	}
-	return true
 }
-func (this *DeleteTreeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CloneTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneTreeRequest.Merge(m, src)
+}
+func (m *CloneTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloneTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneTreeRequest proto.InternalMessageInfo
+
+func (m *CloneTreeRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
+	return nil
+}
 
-	that1, ok := that.(*DeleteTreeResponse)
-	if !ok {
-		that2, ok := that.(DeleteTreeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *CloneTreeRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *CloneTreeRequest) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
 	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
+	return 0
+}
+
+func (m *CloneTreeRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return true
+	return ""
 }
-func (this *InsertRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CloneTreeRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
+	return 0
+}
 
-	that1, ok := that.(*InsertRequest)
-	if !ok {
-		that2, ok := that.(InsertRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CloneTreeResponse struct {
+	Source      *Credentials `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Credentials *Credentials `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	ItemCount   int64        `protobuf:"varint,3,opt,name=itemCount,proto3" json:"itemCount,omitempty"`
+}
+
+func (m *CloneTreeResponse) Reset()      { *m = CloneTreeResponse{} }
+func (*CloneTreeResponse) ProtoMessage() {}
+func (*CloneTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{25}
+}
+func (m *CloneTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloneTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CloneTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
-	}
-	if !this.Item.Equal(that1.Item) {
-		return false
-	}
-	return true
 }
-func (this *InsertResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CloneTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneTreeResponse.Merge(m, src)
+}
+func (m *CloneTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloneTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneTreeResponse proto.InternalMessageInfo
+
+func (m *CloneTreeResponse) GetSource() *Credentials {
+	if m != nil {
+		return m.Source
 	}
+	return nil
+}
 
-	that1, ok := that.(*InsertResponse)
-	if !ok {
-		that2, ok := that.(InsertResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Item.Equal(that1.Item) {
-		return false
+func (m *CloneTreeResponse) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return true
+	return nil
 }
-func (this *MultiInsert) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MultiInsert)
-	if !ok {
-		that2, ok := that.(MultiInsert)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Items) != len(that1.Items) {
-		return false
-	}
-	for i := range this.Items {
-		if !this.Items[i].Equal(that1.Items[i]) {
-			return false
-		}
+func (m *CloneTreeResponse) GetItemCount() int64 {
+	if m != nil {
+		return m.ItemCount
 	}
-	return true
+	return 0
 }
-func (this *DeleteRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DeleteRequest)
-	if !ok {
-		that2, ok := that.(DeleteRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	return true
+// Change lifetime of tree. Negative values remove the limit, 0 keeps it
+type SetTreeExpiryRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Ttl         int64        `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	IdleTimeout int64        `protobuf:"varint,3,opt,name=idleTimeout,proto3" json:"idleTimeout,omitempty"`
+	RequestId   string       `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
-func (this *DeleteResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*DeleteResponse)
-	if !ok {
-		that2, ok := that.(DeleteResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *SetTreeExpiryRequest) Reset()      { *m = SetTreeExpiryRequest{} }
+func (*SetTreeExpiryRequest) ProtoMessage() {}
+func (*SetTreeExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{26}
+}
+func (m *SetTreeExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTreeExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTreeExpiryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Item.Equal(that1.Item) {
-		return false
-	}
-	return true
 }
-func (this *SearchRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *SetTreeExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTreeExpiryRequest.Merge(m, src)
+}
+func (m *SetTreeExpiryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTreeExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTreeExpiryRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*SearchRequest)
-	if !ok {
-		that2, ok := that.(SearchRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_SetTreeExpiryRequest proto.InternalMessageInfo
+
+func (m *SetTreeExpiryRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *SetTreeExpiryRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
+	return 0
+}
+
+func (m *SetTreeExpiryRequest) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
 	}
-	if this.Key != that1.Key {
-		return false
+	return 0
+}
+
+func (m *SetTreeExpiryRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return true
+	return ""
 }
-func (this *SearchResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetTreeExpiryRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
+	return 0
+}
 
-	that1, ok := that.(*SearchResponse)
-	if !ok {
-		that2, ok := that.(SearchResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type SetTreeExpiryResponse struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	// Unix time in milliseconds, 0 if the tree never expires
+	ExpiresAt   int64 `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	IdleTimeout int64 `protobuf:"varint,3,opt,name=idleTimeout,proto3" json:"idleTimeout,omitempty"`
+}
+
+func (m *SetTreeExpiryResponse) Reset()      { *m = SetTreeExpiryResponse{} }
+func (*SetTreeExpiryResponse) ProtoMessage() {}
+func (*SetTreeExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{27}
+}
+func (m *SetTreeExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTreeExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTreeExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *SetTreeExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTreeExpiryResponse.Merge(m, src)
+}
+func (m *SetTreeExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTreeExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTreeExpiryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTreeExpiryResponse proto.InternalMessageInfo
+
+func (m *SetTreeExpiryResponse) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	if !this.Item.Equal(that1.Item) {
-		return false
+	return nil
+}
+
+func (m *SetTreeExpiryResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return true
+	return 0
 }
-func (this *TraverseRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *SetTreeExpiryResponse) GetIdleTimeout() int64 {
+	if m != nil {
+		return m.IdleTimeout
 	}
+	return 0
+}
 
-	that1, ok := that.(*TraverseRequest)
-	if !ok {
-		that2, ok := that.(TraverseRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// Delete tree
+type DeleteTreeRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	RequestId   string       `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *DeleteTreeRequest) Reset()      { *m = DeleteTreeRequest{} }
+func (*DeleteTreeRequest) ProtoMessage() {}
+func (*DeleteTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{28}
+}
+func (m *DeleteTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Credentials.Equal(that1.Credentials) {
-		return false
-	}
-	return true
 }
-func (this *TraverseResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DeleteTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTreeRequest.Merge(m, src)
+}
+func (m *DeleteTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTreeRequest proto.InternalMessageInfo
+
+func (m *DeleteTreeRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
+	return nil
+}
 
-	that1, ok := that.(*TraverseResponse)
-	if !ok {
-		that2, ok := that.(TraverseResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *DeleteTreeRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	if len(this.Items) != len(that1.Items) {
-		return false
+	return ""
+}
+
+func (m *DeleteTreeRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	for i := range this.Items {
-		if !this.Items[i].Equal(that1.Items[i]) {
-			return false
+	return 0
+}
+
+type DeleteTreeResponse struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (m *DeleteTreeResponse) Reset()      { *m = DeleteTreeResponse{} }
+func (*DeleteTreeResponse) ProtoMessage() {}
+func (*DeleteTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{29}
+}
+func (m *DeleteTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *NoSuchTreeError) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.NoSuchTreeError{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *DeleteTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTreeResponse.Merge(m, src)
 }
-func (this *InvalidTokenError) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.InvalidTokenError{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *DeleteTreeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (this *NoSuchKeyError) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.NoSuchKeyError{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *DeleteTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTreeResponse.DiscardUnknown(m)
 }
-func (this *KeyAlreadyExistsError) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.KeyAlreadyExistsError{")
-	if this.Item != nil {
-		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+
+var xxx_messageInfo_DeleteTreeResponse proto.InternalMessageInfo
+
+func (m *DeleteTreeResponse) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *Credentials) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.Credentials{")
-	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
-	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+// Insert into tree
+type InsertRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Item        *Item        `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Version     int64        `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Time to live in milliseconds, converted to expiresAt by the treeservice
+	Ttl int64 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Unix time in milliseconds after which the item is gone, 0 if it never expires
+	ExpiresAt      int64  `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	RequestId      string `protobuf:"bytes,6,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline       int64  `protobuf:"varint,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
-func (this *Item) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.Item{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *InsertRequest) Reset()      { *m = InsertRequest{} }
+func (*InsertRequest) ProtoMessage() {}
+func (*InsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{30}
 }
-func (this *CreateTreeRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.CreateTreeRequest{")
-	s = append(s, "MaxSize: "+fmt.Sprintf("%#v", this.MaxSize)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *CreateTreeResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.CreateTreeResponse{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *DeleteTreeRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.DeleteTreeRequest{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *InsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRequest.Merge(m, src)
 }
-func (this *DeleteTreeResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.DeleteTreeResponse{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *InsertRequest) XXX_Size() int {
+	return m.Size()
 }
-func (this *InsertRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.InsertRequest{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+func (m *InsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+
+func (m *InsertRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	if this.Item != nil {
-		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	return nil
+}
+
+func (m *InsertRequest) GetItem() *Item {
+	if m != nil {
+		return m.Item
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *InsertResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *InsertRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.InsertResponse{")
-	if this.Item != nil {
-		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	return 0
+}
+
+func (m *InsertRequest) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *MultiInsert) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *InsertRequest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.MultiInsert{")
-	if this.Items != nil {
-		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	return 0
+}
+
+func (m *InsertRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *DeleteRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *InsertRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.DeleteRequest{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	return 0
+}
+
+func (m *InsertRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
 	}
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *DeleteResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.DeleteResponse{")
-	if this.Item != nil {
-		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type InsertResponse struct {
+	Item *Item `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
 }
-func (this *SearchRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.SearchRequest{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
-	}
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *InsertResponse) Reset()      { *m = InsertResponse{} }
+func (*InsertResponse) ProtoMessage() {}
+func (*InsertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{31}
 }
-func (this *SearchResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.SearchResponse{")
-	if this.Item != nil {
-		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *InsertResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *TraverseRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.TraverseRequest{")
-	if this.Credentials != nil {
-		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+func (m *InsertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsertResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *TraverseResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.TraverseResponse{")
-	if this.Items != nil {
-		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *InsertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertResponse.Merge(m, src)
 }
-func valueToGoStringTree(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+func (m *InsertResponse) XXX_Size() int {
+	return m.Size()
 }
-func (m *NoSuchTreeError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *InsertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertResponse.DiscardUnknown(m)
 }
 
-func (m *NoSuchTreeError) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Id))
+var xxx_messageInfo_InsertResponse proto.InternalMessageInfo
+
+func (m *InsertResponse) GetItem() *Item {
+	if m != nil {
+		return m.Item
 	}
-	return i, nil
+	return nil
 }
 
-func (m *InvalidTokenError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Insert many items at once. Items whose key exists or is locked are skipped
+type InsertBatchRequest struct {
+	Credentials    *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Items          []*Item      `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Version        int64        `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	RequestId      string       `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline       int64        `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	IdempotencyKey string       `protobuf:"bytes,6,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
-func (m *InvalidTokenError) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n1, err := m.Credentials.MarshalTo(dAtA[i:])
+func (m *InsertBatchRequest) Reset()      { *m = InsertBatchRequest{} }
+func (*InsertBatchRequest) ProtoMessage() {}
+func (*InsertBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{32}
+}
+func (m *InsertBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsertBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsertBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *InsertBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertBatchRequest.Merge(m, src)
+}
+func (m *InsertBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsertBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertBatchRequest.DiscardUnknown(m)
 }
 
-func (m *NoSuchKeyError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InsertBatchRequest proto.InternalMessageInfo
+
+func (m *InsertBatchRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NoSuchKeyError) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Key != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+func (m *InsertBatchRequest) GetItems() []*Item {
+	if m != nil {
+		return m.Items
 	}
-	return i, nil
+	return nil
 }
 
-func (m *KeyAlreadyExistsError) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *InsertBatchRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *KeyAlreadyExistsError) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Item != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
-		n2, err := m.Item.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+func (m *InsertBatchRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Credentials) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *InsertBatchRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Credentials) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Id))
+func (m *InsertBatchRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
 	}
-	if len(m.Token) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(len(m.Token)))
-		i += copy(dAtA[i:], m.Token)
+	return ""
+}
+
+type InsertBatchResponse struct {
+	Inserted int64   `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Existing []*Item `protobuf:"bytes,2,rep,name=existing,proto3" json:"existing,omitempty"`
+	Locked   []int64 `protobuf:"varint,3,rep,packed,name=locked,proto3" json:"locked,omitempty"`
+}
+
+func (m *InsertBatchResponse) Reset()      { *m = InsertBatchResponse{} }
+func (*InsertBatchResponse) ProtoMessage() {}
+func (*InsertBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{33}
+}
+func (m *InsertBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsertBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsertBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *InsertBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertBatchResponse.Merge(m, src)
+}
+func (m *InsertBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsertBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertBatchResponse.DiscardUnknown(m)
 }
 
-func (m *Item) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InsertBatchResponse proto.InternalMessageInfo
+
+func (m *InsertBatchResponse) GetInserted() int64 {
+	if m != nil {
+		return m.Inserted
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Item) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Key != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Key))
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+func (m *InsertBatchResponse) GetExisting() []*Item {
+	if m != nil {
+		return m.Existing
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CreateTreeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *InsertBatchResponse) GetLocked() []int64 {
+	if m != nil {
+		return m.Locked
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreateTreeRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.MaxSize != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.MaxSize))
-	}
-	return i, nil
+// Delete from tree
+type DeleteRequest struct {
+	Credentials    *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Key            int64        `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Version        int64        `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	RequestId      string       `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline       int64        `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	IdempotencyKey string       `protobuf:"bytes,6,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
-func (m *CreateTreeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DeleteRequest) Reset()      { *m = DeleteRequest{} }
+func (*DeleteRequest) ProtoMessage() {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{34}
 }
-
-func (m *CreateTreeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n3, err := m.Credentials.MarshalTo(dAtA[i:])
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
 }
 
-func (m *DeleteTreeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteTreeRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n4, err := m.Credentials.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+func (m *DeleteRequest) GetKey() int64 {
+	if m != nil {
+		return m.Key
 	}
-	return i, nil
+	return 0
 }
 
-func (m *DeleteTreeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeleteRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeleteTreeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n5, err := m.Credentials.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+func (m *DeleteRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeleteRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n6, err := m.Credentials.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if m.Item != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
-		n7, err := m.Item.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+func (m *DeleteRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
 	}
-	return i, nil
+	return ""
 }
 
-func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type DeleteResponse struct {
+	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Item != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
-		n8, err := m.Item.MarshalTo(dAtA[i:])
+func (m *DeleteResponse) Reset()      { *m = DeleteResponse{} }
+func (*DeleteResponse) ProtoMessage() {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{35}
+}
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n8
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
 }
 
-func (m *MultiInsert) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+func (m *DeleteResponse) GetItem() *Item {
+	if m != nil {
+		return m.Item
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MultiInsert) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+// Search in tree
+type SearchRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Key         int64        `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Snapshot    *Snapshot    `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	RequestId   string       `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SearchRequest) Reset()      { *m = SearchRequest{} }
+func (*SearchRequest) ProtoMessage() {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{36}
 }
-
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n9, err := m.Credentials.MarshalTo(dAtA[i:])
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n9
-	}
-	if m.Key != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
 }
 
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Item != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
-		n10, err := m.Item.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+func (m *SearchRequest) GetKey() int64 {
+	if m != nil {
+		return m.Key
 	}
-	return i, nil
+	return 0
 }
 
-func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SearchRequest) GetSnapshot() *Snapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n11, err := m.Credentials.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
-	}
-	if m.Key != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+func (m *SearchRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SearchRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Item != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
-		n12, err := m.Item.MarshalTo(dAtA[i:])
+type SearchResponse struct {
+	Item *Item `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (m *SearchResponse) Reset()      { *m = SearchResponse{} }
+func (*SearchResponse) ProtoMessage() {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{37}
+}
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n12
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(m, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
 }
 
-func (m *TraverseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetItem() *Item {
+	if m != nil {
+		return m.Item
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TraverseRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
-		n13, err := m.Credentials.MarshalTo(dAtA[i:])
+// Traverse tree
+type TraverseRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Snapshot    *Snapshot    `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	RequestId   string       `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *TraverseRequest) Reset()      { *m = TraverseRequest{} }
+func (*TraverseRequest) ProtoMessage() {}
+func (*TraverseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{38}
+}
+func (m *TraverseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraverseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraverseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n13
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *TraverseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraverseRequest.Merge(m, src)
+}
+func (m *TraverseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraverseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraverseRequest.DiscardUnknown(m)
 }
 
-func (m *TraverseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TraverseRequest proto.InternalMessageInfo
+
+func (m *TraverseRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TraverseResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *TraverseRequest) GetSnapshot() *Snapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return i, nil
+	return nil
 }
 
-func encodeVarintTree(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TraverseRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return ""
 }
-func (m *NoSuchTreeError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTree(uint64(m.Id))
+
+func (m *TraverseRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return n
+	return 0
 }
 
-func (m *InvalidTokenError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
-	}
-	return n
+type TraverseResponse struct {
+	Items []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (m *NoSuchKeyError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovTree(uint64(m.Key))
+func (m *TraverseResponse) Reset()      { *m = TraverseResponse{} }
+func (*TraverseResponse) ProtoMessage() {}
+func (*TraverseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{39}
+}
+func (m *TraverseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraverseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraverseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TraverseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraverseResponse.Merge(m, src)
+}
+func (m *TraverseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraverseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraverseResponse.DiscardUnknown(m)
 }
 
-func (m *KeyAlreadyExistsError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTree(uint64(l))
+var xxx_messageInfo_TraverseResponse proto.InternalMessageInfo
+
+func (m *TraverseResponse) GetItems() []*Item {
+	if m != nil {
+		return m.Items
 	}
-	return n
+	return nil
 }
 
-func (m *Credentials) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTree(uint64(m.Id))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovTree(uint64(l))
-	}
-	return n
+// Get items with from <= key <= to sorted by key
+type RangeRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	From        int64        `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To          int64        `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	Snapshot    *Snapshot    `protobuf:"bytes,4,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	RequestId   string       `protobuf:"bytes,5,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *Item) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovTree(uint64(m.Key))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTree(uint64(l))
+func (m *RangeRequest) Reset()      { *m = RangeRequest{} }
+func (*RangeRequest) ProtoMessage() {}
+func (*RangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{40}
+}
+func (m *RangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeRequest.Merge(m, src)
+}
+func (m *RangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeRequest.DiscardUnknown(m)
 }
 
-func (m *CreateTreeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxSize != 0 {
-		n += 1 + sovTree(uint64(m.MaxSize))
+var xxx_messageInfo_RangeRequest proto.InternalMessageInfo
+
+func (m *RangeRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return n
+	return nil
 }
 
-func (m *CreateTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
+func (m *RangeRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
 	}
-	return n
+	return 0
 }
 
-func (m *DeleteTreeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
+func (m *RangeRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
 	}
-	return n
+	return 0
 }
 
-func (m *DeleteTreeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
+func (m *RangeRequest) GetSnapshot() *Snapshot {
+	if m != nil {
+		return m.Snapshot
 	}
-	return n
+	return nil
 }
 
-func (m *InsertRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
-	}
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTree(uint64(l))
+func (m *RangeRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return n
+	return ""
 }
 
-func (m *InsertResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTree(uint64(l))
+func (m *RangeRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return n
+	return 0
 }
 
-func (m *MultiInsert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTree(uint64(l))
+type RangeResponse struct {
+	Items []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (m *RangeResponse) Reset()      { *m = RangeResponse{} }
+func (*RangeResponse) ProtoMessage() {}
+func (*RangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{41}
+}
+func (m *RangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeResponse.Merge(m, src)
+}
+func (m *RangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeResponse.DiscardUnknown(m)
 }
 
-func (m *DeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
-	}
-	if m.Key != 0 {
-		n += 1 + sovTree(uint64(m.Key))
+var xxx_messageInfo_RangeResponse proto.InternalMessageInfo
+
+func (m *RangeResponse) GetItems() []*Item {
+	if m != nil {
+		return m.Items
 	}
-	return n
+	return nil
 }
 
-func (m *DeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTree(uint64(l))
-	}
-	return n
+// Snapshots
+type SnapshotRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	RequestId   string       `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (m *SearchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
-	}
-	if m.Key != 0 {
-		n += 1 + sovTree(uint64(m.Key))
+func (m *SnapshotRequest) Reset()      { *m = SnapshotRequest{} }
+func (*SnapshotRequest) ProtoMessage() {}
+func (*SnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{42}
+}
+func (m *SnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotRequest.Merge(m, src)
+}
+func (m *SnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotRequest.DiscardUnknown(m)
 }
 
-func (m *SearchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovTree(uint64(l))
+var xxx_messageInfo_SnapshotRequest proto.InternalMessageInfo
+
+func (m *SnapshotRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	return n
+	return nil
 }
 
-func (m *TraverseRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Credentials != nil {
-		l = m.Credentials.Size()
-		n += 1 + l + sovTree(uint64(l))
+func (m *SnapshotRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	return n
+	return ""
 }
 
-func (m *TraverseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovTree(uint64(l))
-		}
+func (m *SnapshotRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	return n
+	return 0
 }
 
-func sovTree(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+type SnapshotResponse struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	SnapshotId  int64        `protobuf:"varint,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+}
+
+func (m *SnapshotResponse) Reset()      { *m = SnapshotResponse{} }
+func (*SnapshotResponse) ProtoMessage() {}
+func (*SnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{43}
+}
+func (m *SnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-func sozTree(x uint64) (n int) {
-	return sovTree(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotResponse.Merge(m, src)
 }
-func (this *NoSuchTreeError) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&NoSuchTreeError{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *SnapshotResponse) XXX_Size() int {
+	return m.Size()
 }
-func (this *InvalidTokenError) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&InvalidTokenError{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *SnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotResponse.DiscardUnknown(m)
 }
-func (this *NoSuchKeyError) String() string {
-	if this == nil {
-		return "nil"
+
+var xxx_messageInfo_SnapshotResponse proto.InternalMessageInfo
+
+func (m *SnapshotResponse) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	s := strings.Join([]string{`&NoSuchKeyError{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *KeyAlreadyExistsError) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SnapshotResponse) GetSnapshotId() int64 {
+	if m != nil {
+		return m.SnapshotId
 	}
-	s := strings.Join([]string{`&KeyAlreadyExistsError{`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *Credentials) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Credentials{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
-		`}`,
-	}, "")
-	return s
+
+type ReleaseSnapshotRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	SnapshotId  int64        `protobuf:"varint,2,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	RequestId   string       `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
-func (this *Item) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Item{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ReleaseSnapshotRequest) Reset()      { *m = ReleaseSnapshotRequest{} }
+func (*ReleaseSnapshotRequest) ProtoMessage() {}
+func (*ReleaseSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{44}
 }
-func (this *CreateTreeRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CreateTreeRequest{`,
-		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ReleaseSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *CreateTreeResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *ReleaseSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseSnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&CreateTreeResponse{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *DeleteTreeRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DeleteTreeRequest{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ReleaseSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseSnapshotRequest.Merge(m, src)
 }
-func (this *DeleteTreeResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&DeleteTreeResponse{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ReleaseSnapshotRequest) XXX_Size() int {
+	return m.Size()
 }
-func (this *InsertRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&InsertRequest{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ReleaseSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseSnapshotRequest.DiscardUnknown(m)
 }
-func (this *InsertResponse) String() string {
-	if this == nil {
-		return "nil"
+
+var xxx_messageInfo_ReleaseSnapshotRequest proto.InternalMessageInfo
+
+func (m *ReleaseSnapshotRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
 	}
-	s := strings.Join([]string{`&InsertResponse{`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *MultiInsert) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReleaseSnapshotRequest) GetSnapshotId() int64 {
+	if m != nil {
+		return m.SnapshotId
 	}
-	s := strings.Join([]string{`&MultiInsert{`,
-		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Item", "Item", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *DeleteRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReleaseSnapshotRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	s := strings.Join([]string{`&DeleteRequest{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+	return ""
 }
-func (this *DeleteResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReleaseSnapshotRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
 	}
-	s := strings.Join([]string{`&DeleteResponse{`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *SearchRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SearchRequest{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
+
+type ReleaseSnapshotResponse struct {
+	SnapshotId int64 `protobuf:"varint,1,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
 }
-func (this *SearchResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReleaseSnapshotResponse) Reset()      { *m = ReleaseSnapshotResponse{} }
+func (*ReleaseSnapshotResponse) ProtoMessage() {}
+func (*ReleaseSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{45}
+}
+func (m *ReleaseSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := strings.Join([]string{`&SearchResponse{`,
-		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
-		`}`,
-	}, "")
-	return s
 }
-func (this *TraverseRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *ReleaseSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseSnapshotResponse.Merge(m, src)
+}
+func (m *ReleaseSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseSnapshotResponse proto.InternalMessageInfo
+
+func (m *ReleaseSnapshotResponse) GetSnapshotId() int64 {
+	if m != nil {
+		return m.SnapshotId
 	}
-	s := strings.Join([]string{`&TraverseRequest{`,
-		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return 0
 }
-func (this *TraverseResponse) String() string {
-	if this == nil {
-		return "nil"
+
+// Versions of all snapshots in use, sent to every leaf so they can drop older versions
+type ActiveSnapshots struct {
+	Versions  []int64 `protobuf:"varint,1,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	RequestId string  `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (m *ActiveSnapshots) Reset()      { *m = ActiveSnapshots{} }
+func (*ActiveSnapshots) ProtoMessage() {}
+func (*ActiveSnapshots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{46}
+}
+func (m *ActiveSnapshots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveSnapshots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveSnapshots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveSnapshots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveSnapshots.Merge(m, src)
+}
+func (m *ActiveSnapshots) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveSnapshots) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveSnapshots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveSnapshots proto.InternalMessageInfo
+
+func (m *ActiveSnapshots) GetVersions() []int64 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *ActiveSnapshots) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+// Deletes all items expired at now (unix time in milliseconds) in the given version
+type PurgeExpired struct {
+	Version   int64  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Now       int64  `protobuf:"varint,2,opt,name=now,proto3" json:"now,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (m *PurgeExpired) Reset()      { *m = PurgeExpired{} }
+func (*PurgeExpired) ProtoMessage() {}
+func (*PurgeExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{47}
+}
+func (m *PurgeExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PurgeExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PurgeExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PurgeExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurgeExpired.Merge(m, src)
+}
+func (m *PurgeExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *PurgeExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurgeExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurgeExpired proto.InternalMessageInfo
+
+func (m *PurgeExpired) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PurgeExpired) GetNow() int64 {
+	if m != nil {
+		return m.Now
+	}
+	return 0
+}
+
+func (m *PurgeExpired) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+// Transactions
+type TxOperation struct {
+	Type TxOperation_Type `protobuf:"varint,1,opt,name=type,proto3,enum=messages.TxOperation_Type" json:"type,omitempty"`
+	Item *Item            `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (m *TxOperation) Reset()      { *m = TxOperation{} }
+func (*TxOperation) ProtoMessage() {}
+func (*TxOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{48}
+}
+func (m *TxOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOperation.Merge(m, src)
+}
+func (m *TxOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxOperation proto.InternalMessageInfo
+
+func (m *TxOperation) GetType() TxOperation_Type {
+	if m != nil {
+		return m.Type
+	}
+	return INSERT
+}
+
+func (m *TxOperation) GetItem() *Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type BeginTxRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	RequestId   string       `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *BeginTxRequest) Reset()      { *m = BeginTxRequest{} }
+func (*BeginTxRequest) ProtoMessage() {}
+func (*BeginTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{49}
+}
+func (m *BeginTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeginTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginTxRequest.Merge(m, src)
+}
+func (m *BeginTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginTxRequest proto.InternalMessageInfo
+
+func (m *BeginTxRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *BeginTxRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *BeginTxRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+type BeginTxResponse struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	TxId        int64        `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+}
+
+func (m *BeginTxResponse) Reset()      { *m = BeginTxResponse{} }
+func (*BeginTxResponse) ProtoMessage() {}
+func (*BeginTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{50}
+}
+func (m *BeginTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeginTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginTxResponse.Merge(m, src)
+}
+func (m *BeginTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginTxResponse proto.InternalMessageInfo
+
+func (m *BeginTxResponse) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *BeginTxResponse) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+type StageTxRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	TxId        int64        `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	Operation   *TxOperation `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	RequestId   string       `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *StageTxRequest) Reset()      { *m = StageTxRequest{} }
+func (*StageTxRequest) ProtoMessage() {}
+func (*StageTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{51}
+}
+func (m *StageTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StageTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StageTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StageTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageTxRequest.Merge(m, src)
+}
+func (m *StageTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StageTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StageTxRequest proto.InternalMessageInfo
+
+func (m *StageTxRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *StageTxRequest) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *StageTxRequest) GetOperation() *TxOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+func (m *StageTxRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *StageTxRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+type StageTxResponse struct {
+	TxId      int64        `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Operation *TxOperation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (m *StageTxResponse) Reset()      { *m = StageTxResponse{} }
+func (*StageTxResponse) ProtoMessage() {}
+func (*StageTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{52}
+}
+func (m *StageTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StageTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StageTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StageTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageTxResponse.Merge(m, src)
+}
+func (m *StageTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StageTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StageTxResponse proto.InternalMessageInfo
+
+func (m *StageTxResponse) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *StageTxResponse) GetOperation() *TxOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+type CommitTxRequest struct {
+	Credentials    *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	TxId           int64        `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	RequestId      string       `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline       int64        `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	IdempotencyKey string       `protobuf:"bytes,5,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (m *CommitTxRequest) Reset()      { *m = CommitTxRequest{} }
+func (*CommitTxRequest) ProtoMessage() {}
+func (*CommitTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{53}
+}
+func (m *CommitTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTxRequest.Merge(m, src)
+}
+func (m *CommitTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitTxRequest proto.InternalMessageInfo
+
+func (m *CommitTxRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *CommitTxRequest) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *CommitTxRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *CommitTxRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *CommitTxRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+type CommitTxResponse struct {
+	TxId       int64          `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Operations []*TxOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (m *CommitTxResponse) Reset()      { *m = CommitTxResponse{} }
+func (*CommitTxResponse) ProtoMessage() {}
+func (*CommitTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{54}
+}
+func (m *CommitTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTxResponse.Merge(m, src)
+}
+func (m *CommitTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitTxResponse proto.InternalMessageInfo
+
+func (m *CommitTxResponse) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *CommitTxResponse) GetOperations() []*TxOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type AbortTxRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	TxId        int64        `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	RequestId   string       `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *AbortTxRequest) Reset()      { *m = AbortTxRequest{} }
+func (*AbortTxRequest) ProtoMessage() {}
+func (*AbortTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{55}
+}
+func (m *AbortTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbortTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbortTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AbortTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortTxRequest.Merge(m, src)
+}
+func (m *AbortTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbortTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortTxRequest proto.InternalMessageInfo
+
+func (m *AbortTxRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *AbortTxRequest) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *AbortTxRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AbortTxRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+type AbortTxResponse struct {
+	TxId int64 `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+}
+
+func (m *AbortTxResponse) Reset()      { *m = AbortTxResponse{} }
+func (*AbortTxResponse) ProtoMessage() {}
+func (*AbortTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{56}
+}
+func (m *AbortTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbortTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbortTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AbortTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortTxResponse.Merge(m, src)
+}
+func (m *AbortTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AbortTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortTxResponse proto.InternalMessageInfo
+
+func (m *AbortTxResponse) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+// Two-phase commit between transaction coordinator and leafs
+type TxPrepare struct {
+	TxId      int64        `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Operation *TxOperation `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	RequestId string       `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (m *TxPrepare) Reset()      { *m = TxPrepare{} }
+func (*TxPrepare) ProtoMessage() {}
+func (*TxPrepare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{57}
+}
+func (m *TxPrepare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxPrepare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxPrepare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxPrepare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPrepare.Merge(m, src)
+}
+func (m *TxPrepare) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxPrepare) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPrepare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPrepare proto.InternalMessageInfo
+
+func (m *TxPrepare) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxPrepare) GetOperation() *TxOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+func (m *TxPrepare) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type TxVote struct {
+	TxId   int64  `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Key    int64  `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Commit bool   `protobuf:"varint,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *TxVote) Reset()      { *m = TxVote{} }
+func (*TxVote) ProtoMessage() {}
+func (*TxVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{58}
+}
+func (m *TxVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxVote.Merge(m, src)
+}
+func (m *TxVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxVote proto.InternalMessageInfo
+
+func (m *TxVote) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxVote) GetKey() int64 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *TxVote) GetCommit() bool {
+	if m != nil {
+		return m.Commit
+	}
+	return false
+}
+
+func (m *TxVote) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Once all leafs voted to commit, the coordinator asks the treeservice for the version to commit in. Versions are
+// assigned only then, so they are above those of all writes applied before the keys were locked
+type TxVersionRequest struct {
+	TxId      int64  `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (m *TxVersionRequest) Reset()      { *m = TxVersionRequest{} }
+func (*TxVersionRequest) ProtoMessage() {}
+func (*TxVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{59}
+}
+func (m *TxVersionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxVersionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxVersionRequest.Merge(m, src)
+}
+func (m *TxVersionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxVersionRequest proto.InternalMessageInfo
+
+func (m *TxVersionRequest) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxVersionRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type TxVersionResponse struct {
+	TxId    int64 `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *TxVersionResponse) Reset()      { *m = TxVersionResponse{} }
+func (*TxVersionResponse) ProtoMessage() {}
+func (*TxVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{60}
+}
+func (m *TxVersionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxVersionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxVersionResponse.Merge(m, src)
+}
+func (m *TxVersionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxVersionResponse proto.InternalMessageInfo
+
+func (m *TxVersionResponse) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxVersionResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type TxCommit struct {
+	TxId      int64  `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Key       int64  `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Version   int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	RequestId string `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (m *TxCommit) Reset()      { *m = TxCommit{} }
+func (*TxCommit) ProtoMessage() {}
+func (*TxCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{61}
+}
+func (m *TxCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxCommit.Merge(m, src)
+}
+func (m *TxCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxCommit proto.InternalMessageInfo
+
+func (m *TxCommit) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxCommit) GetKey() int64 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *TxCommit) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TxCommit) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type TxAbort struct {
+	TxId      int64  `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Key       int64  `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (m *TxAbort) Reset()      { *m = TxAbort{} }
+func (*TxAbort) ProtoMessage() {}
+func (*TxAbort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{62}
+}
+func (m *TxAbort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxAbort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxAbort.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxAbort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxAbort.Merge(m, src)
+}
+func (m *TxAbort) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxAbort) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxAbort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxAbort proto.InternalMessageInfo
+
+func (m *TxAbort) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxAbort) GetKey() int64 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+func (m *TxAbort) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type TxAck struct {
+	TxId int64 `protobuf:"varint,1,opt,name=txId,proto3" json:"txId,omitempty"`
+	Key  int64 `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *TxAck) Reset()      { *m = TxAck{} }
+func (*TxAck) ProtoMessage() {}
+func (*TxAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{63}
+}
+func (m *TxAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxAck.Merge(m, src)
+}
+func (m *TxAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxAck proto.InternalMessageInfo
+
+func (m *TxAck) GetTxId() int64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxAck) GetKey() int64 {
+	if m != nil {
+		return m.Key
+	}
+	return 0
+}
+
+// First message of a tree exported in protobuf format, followed by its items
+type ExportHeader struct {
+	MaxSize   int64 `protobuf:"varint,1,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	ItemCount int64 `protobuf:"varint,2,opt,name=itemCount,proto3" json:"itemCount,omitempty"`
+}
+
+func (m *ExportHeader) Reset()      { *m = ExportHeader{} }
+func (*ExportHeader) ProtoMessage() {}
+func (*ExportHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{64}
+}
+func (m *ExportHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExportHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportHeader.Merge(m, src)
+}
+func (m *ExportHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportHeader proto.InternalMessageInfo
+
+func (m *ExportHeader) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *ExportHeader) GetItemCount() int64 {
+	if m != nil {
+		return m.ItemCount
+	}
+	return 0
+}
+
+// Helper message for splitting up node
+type MultiInsert struct {
+	Items          []*Item          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Locks          []*TxPrepare     `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks,omitempty"`
+	VersionedItems []*VersionedItem `protobuf:"bytes,3,rep,name=versionedItems,proto3" json:"versionedItems,omitempty"`
+	Snapshots      []int64          `protobuf:"varint,4,rep,packed,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// Set when a leaf passes its items on while splitting or the treeservice counted the items of a clone already,
+	// so the new leafs don't report them as added
+	Moved bool `protobuf:"varint,5,opt,name=moved,proto3" json:"moved,omitempty"`
+}
+
+func (m *MultiInsert) Reset()      { *m = MultiInsert{} }
+func (*MultiInsert) ProtoMessage() {}
+func (*MultiInsert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{65}
+}
+func (m *MultiInsert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiInsert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiInsert.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiInsert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiInsert.Merge(m, src)
+}
+func (m *MultiInsert) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiInsert) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiInsert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiInsert proto.InternalMessageInfo
+
+func (m *MultiInsert) GetItems() []*Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *MultiInsert) GetLocks() []*TxPrepare {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *MultiInsert) GetVersionedItems() []*VersionedItem {
+	if m != nil {
+		return m.VersionedItems
+	}
+	return nil
+}
+
+func (m *MultiInsert) GetSnapshots() []int64 {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *MultiInsert) GetMoved() bool {
+	if m != nil {
+		return m.Moved
+	}
+	return false
+}
+
+// Change of the number of items and bytes in a subtree, which each node reports to its parent.
+// The root reports to the treeservice, which releases the room reserved for the request with the id
+type SizeDelta struct {
+	Items     int64  `protobuf:"varint,1,opt,name=items,proto3" json:"items,omitempty"`
+	Bytes     int64  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (m *SizeDelta) Reset()      { *m = SizeDelta{} }
+func (*SizeDelta) ProtoMessage() {}
+func (*SizeDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{66}
+}
+func (m *SizeDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SizeDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SizeDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SizeDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SizeDelta.Merge(m, src)
+}
+func (m *SizeDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *SizeDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SizeDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SizeDelta proto.InternalMessageInfo
+
+func (m *SizeDelta) GetItems() int64 {
+	if m != nil {
+		return m.Items
+	}
+	return 0
+}
+
+func (m *SizeDelta) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *SizeDelta) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+// Subscribe to the changes of a tree
+type WatchRequest struct {
+	Credentials *Credentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	RequestId   string       `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *WatchRequest) Reset()      { *m = WatchRequest{} }
+func (*WatchRequest) ProtoMessage() {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{67}
+}
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+type WatchEvent struct {
+	Type WatchEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=messages.WatchEvent_Type" json:"type,omitempty"`
+	Item *Item           `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (m *WatchEvent) Reset()      { *m = WatchEvent{} }
+func (*WatchEvent) ProtoMessage() {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{68}
+}
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetType() WatchEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return INSERTED
+}
+
+func (m *WatchEvent) GetItem() *Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+// Tracing
+// Handling of a traced message by one actor. Times are unix time in nanoseconds.
+type Span struct {
+	TraceId string `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	SpanId  string `protobuf:"bytes,2,opt,name=spanId,proto3" json:"spanId,omitempty"`
+	// Empty for the first span of a trace
+	ParentSpanId string `protobuf:"bytes,3,opt,name=parentSpanId,proto3" json:"parentSpanId,omitempty"`
+	Name         string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Actor        string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Time the message was sent, startedAt - queuedAt is the time it waited in the mailbox
+	QueuedAt  int64 `protobuf:"varint,6,opt,name=queuedAt,proto3" json:"queuedAt,omitempty"`
+	StartedAt int64 `protobuf:"varint,7,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	EndedAt   int64 `protobuf:"varint,8,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+}
+
+func (m *Span) Reset()      { *m = Span{} }
+func (*Span) ProtoMessage() {}
+func (*Span) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{69}
+}
+func (m *Span) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Span) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Span.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Span) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Span.Merge(m, src)
+}
+func (m *Span) XXX_Size() int {
+	return m.Size()
+}
+func (m *Span) XXX_DiscardUnknown() {
+	xxx_messageInfo_Span.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Span proto.InternalMessageInfo
+
+func (m *Span) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *Span) GetSpanId() string {
+	if m != nil {
+		return m.SpanId
+	}
+	return ""
+}
+
+func (m *Span) GetParentSpanId() string {
+	if m != nil {
+		return m.ParentSpanId
+	}
+	return ""
+}
+
+func (m *Span) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Span) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *Span) GetQueuedAt() int64 {
+	if m != nil {
+		return m.QueuedAt
+	}
+	return 0
+}
+
+func (m *Span) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *Span) GetEndedAt() int64 {
+	if m != nil {
+		return m.EndedAt
+	}
+	return 0
+}
+
+// Returns the spans the treeservice recorded for the trace of a request to the tree
+type TraceRequest struct {
+	TraceId     string       `protobuf:"bytes,1,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	RequestId   string       `protobuf:"bytes,2,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Deadline    int64        `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Credentials *Credentials `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (m *TraceRequest) Reset()      { *m = TraceRequest{} }
+func (*TraceRequest) ProtoMessage() {}
+func (*TraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{70}
+}
+func (m *TraceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceRequest.Merge(m, src)
+}
+func (m *TraceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceRequest proto.InternalMessageInfo
+
+func (m *TraceRequest) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *TraceRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *TraceRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *TraceRequest) GetCredentials() *Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+type TraceResponse struct {
+	Spans []*Span `protobuf:"bytes,1,rep,name=spans,proto3" json:"spans,omitempty"`
+}
+
+func (m *TraceResponse) Reset()      { *m = TraceResponse{} }
+func (*TraceResponse) ProtoMessage() {}
+func (*TraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3889276909882a, []int{71}
+}
+func (m *TraceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceResponse.Merge(m, src)
+}
+func (m *TraceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceResponse proto.InternalMessageInfo
+
+func (m *TraceResponse) GetSpans() []*Span {
+	if m != nil {
+		return m.Spans
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("messages.TxOperation_Type", TxOperation_Type_name, TxOperation_Type_value)
+	proto.RegisterEnum("messages.WatchEvent_Type", WatchEvent_Type_name, WatchEvent_Type_value)
+	proto.RegisterType((*Credentials)(nil), "messages.Credentials")
+	proto.RegisterType((*Item)(nil), "messages.Item")
+	proto.RegisterType((*Version)(nil), "messages.Version")
+	proto.RegisterType((*VersionedItem)(nil), "messages.VersionedItem")
+	proto.RegisterType((*Quota)(nil), "messages.Quota")
+	proto.RegisterType((*Snapshot)(nil), "messages.Snapshot")
+	proto.RegisterType((*NoSuchTreeError)(nil), "messages.NoSuchTreeError")
+	proto.RegisterType((*InvalidTokenError)(nil), "messages.InvalidTokenError")
+	proto.RegisterType((*NoSuchKeyError)(nil), "messages.NoSuchKeyError")
+	proto.RegisterType((*KeyAlreadyExistsError)(nil), "messages.KeyAlreadyExistsError")
+	proto.RegisterType((*NoSuchTxError)(nil), "messages.NoSuchTxError")
+	proto.RegisterType((*TxAbortedError)(nil), "messages.TxAbortedError")
+	proto.RegisterType((*NoSuchSnapshotError)(nil), "messages.NoSuchSnapshotError")
+	proto.RegisterType((*KeyLockedError)(nil), "messages.KeyLockedError")
+	proto.RegisterType((*DeadlineExceededError)(nil), "messages.DeadlineExceededError")
+	proto.RegisterType((*QuotaExceededError)(nil), "messages.QuotaExceededError")
+	proto.RegisterType((*RateLimitedError)(nil), "messages.RateLimitedError")
+	proto.RegisterType((*OverloadedError)(nil), "messages.OverloadedError")
+	proto.RegisterType((*ShuttingDownError)(nil), "messages.ShuttingDownError")
+	proto.RegisterType((*InvalidRequestError)(nil), "messages.InvalidRequestError")
+	proto.RegisterType((*CreateTreeRequest)(nil), "messages.CreateTreeRequest")
+	proto.RegisterType((*CreateTreeResponse)(nil), "messages.CreateTreeResponse")
+	proto.RegisterType((*TreeInfoRequest)(nil), "messages.TreeInfoRequest")
+	proto.RegisterType((*TreeInfoResponse)(nil), "messages.TreeInfoResponse")
+	proto.RegisterType((*CloneTreeRequest)(nil), "messages.CloneTreeRequest")
+	proto.RegisterType((*CloneTreeResponse)(nil), "messages.CloneTreeResponse")
+	proto.RegisterType((*SetTreeExpiryRequest)(nil), "messages.SetTreeExpiryRequest")
+	proto.RegisterType((*SetTreeExpiryResponse)(nil), "messages.SetTreeExpiryResponse")
+	proto.RegisterType((*DeleteTreeRequest)(nil), "messages.DeleteTreeRequest")
+	proto.RegisterType((*DeleteTreeResponse)(nil), "messages.DeleteTreeResponse")
+	proto.RegisterType((*InsertRequest)(nil), "messages.InsertRequest")
+	proto.RegisterType((*InsertResponse)(nil), "messages.InsertResponse")
+	proto.RegisterType((*InsertBatchRequest)(nil), "messages.InsertBatchRequest")
+	proto.RegisterType((*InsertBatchResponse)(nil), "messages.InsertBatchResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "messages.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "messages.DeleteResponse")
+	proto.RegisterType((*SearchRequest)(nil), "messages.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "messages.SearchResponse")
+	proto.RegisterType((*TraverseRequest)(nil), "messages.TraverseRequest")
+	proto.RegisterType((*TraverseResponse)(nil), "messages.TraverseResponse")
+	proto.RegisterType((*RangeRequest)(nil), "messages.RangeRequest")
+	proto.RegisterType((*RangeResponse)(nil), "messages.RangeResponse")
+	proto.RegisterType((*SnapshotRequest)(nil), "messages.SnapshotRequest")
+	proto.RegisterType((*SnapshotResponse)(nil), "messages.SnapshotResponse")
+	proto.RegisterType((*ReleaseSnapshotRequest)(nil), "messages.ReleaseSnapshotRequest")
+	proto.RegisterType((*ReleaseSnapshotResponse)(nil), "messages.ReleaseSnapshotResponse")
+	proto.RegisterType((*ActiveSnapshots)(nil), "messages.ActiveSnapshots")
+	proto.RegisterType((*PurgeExpired)(nil), "messages.PurgeExpired")
+	proto.RegisterType((*TxOperation)(nil), "messages.TxOperation")
+	proto.RegisterType((*BeginTxRequest)(nil), "messages.BeginTxRequest")
+	proto.RegisterType((*BeginTxResponse)(nil), "messages.BeginTxResponse")
+	proto.RegisterType((*StageTxRequest)(nil), "messages.StageTxRequest")
+	proto.RegisterType((*StageTxResponse)(nil), "messages.StageTxResponse")
+	proto.RegisterType((*CommitTxRequest)(nil), "messages.CommitTxRequest")
+	proto.RegisterType((*CommitTxResponse)(nil), "messages.CommitTxResponse")
+	proto.RegisterType((*AbortTxRequest)(nil), "messages.AbortTxRequest")
+	proto.RegisterType((*AbortTxResponse)(nil), "messages.AbortTxResponse")
+	proto.RegisterType((*TxPrepare)(nil), "messages.TxPrepare")
+	proto.RegisterType((*TxVote)(nil), "messages.TxVote")
+	proto.RegisterType((*TxVersionRequest)(nil), "messages.TxVersionRequest")
+	proto.RegisterType((*TxVersionResponse)(nil), "messages.TxVersionResponse")
+	proto.RegisterType((*TxCommit)(nil), "messages.TxCommit")
+	proto.RegisterType((*TxAbort)(nil), "messages.TxAbort")
+	proto.RegisterType((*TxAck)(nil), "messages.TxAck")
+	proto.RegisterType((*ExportHeader)(nil), "messages.ExportHeader")
+	proto.RegisterType((*MultiInsert)(nil), "messages.MultiInsert")
+	proto.RegisterType((*SizeDelta)(nil), "messages.SizeDelta")
+	proto.RegisterType((*WatchRequest)(nil), "messages.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "messages.WatchEvent")
+	proto.RegisterType((*Span)(nil), "messages.Span")
+	proto.RegisterType((*TraceRequest)(nil), "messages.TraceRequest")
+	proto.RegisterType((*TraceResponse)(nil), "messages.TraceResponse")
+}
+
+func init() { proto.RegisterFile("tree.proto", fileDescriptor_cb3889276909882a) }
+
+var fileDescriptor_cb3889276909882a = []byte{
+	// 2288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0x9f, 0xf2, 0xd7, 0x78, 0x9e, 0x67, 0x6c, 0x4f, 0xe7, 0xcb, 0xe9, 0x0d, 0x66, 0xb6, 0xd8,
+	0x45, 0x61, 0xa5, 0x8c, 0x56, 0x93, 0x84, 0x5d, 0xb4, 0x08, 0xe4, 0xc4, 0x66, 0x19, 0x92, 0xec,
+	0x86, 0xb6, 0x37, 0xa0, 0x95, 0x58, 0xa9, 0xd7, 0x5d, 0x99, 0x69, 0xc5, 0xee, 0x76, 0xba, 0xcb,
+	0x13, 0x9b, 0x13, 0x2b, 0x10, 0x37, 0xc4, 0xde, 0x00, 0xf1, 0x0f, 0x20, 0x71, 0xe5, 0xc2, 0x01,
+	0x09, 0x21, 0x0e, 0x1c, 0x38, 0x44, 0x42, 0xa0, 0x15, 0x27, 0x32, 0xb9, 0x70, 0x63, 0x8f, 0x1c,
+	0x51, 0x7d, 0x75, 0x57, 0xb7, 0xdb, 0x9e, 0x0f, 0xcf, 0x12, 0xb8, 0xf5, 0xab, 0x7a, 0xf5, 0xea,
+	0xbd, 0xdf, 0x7b, 0x55, 0xf5, 0xea, 0x55, 0x03, 0xd0, 0x80, 0x90, 0xed, 0x51, 0xe0, 0x53, 0xdf,
+	0x28, 0x0f, 0x49, 0x18, 0xda, 0x7b, 0x24, 0xc4, 0xd7, 0xa1, 0x72, 0x3b, 0x20, 0x0e, 0xf1, 0xa8,
+	0x6b, 0x0f, 0x42, 0xa3, 0x0a, 0x39, 0xd7, 0x69, 0xa0, 0x2d, 0x74, 0x35, 0x6f, 0xe5, 0x5c, 0xc7,
+	0x38, 0x0f, 0x45, 0xea, 0x3f, 0x22, 0x5e, 0x23, 0xb7, 0x85, 0xae, 0xae, 0x59, 0x82, 0xc0, 0x77,
+	0xa1, 0xb0, 0x4b, 0xc9, 0xd0, 0xa8, 0x43, 0xfe, 0x11, 0x99, 0x4a, 0x76, 0xf6, 0xc9, 0xf8, 0x0f,
+	0xec, 0xc1, 0x98, 0x28, 0x7e, 0x4e, 0x18, 0x57, 0x60, 0x8d, 0x4c, 0x46, 0x6e, 0x40, 0xc2, 0x16,
+	0x6d, 0xe4, 0x39, 0x77, 0xdc, 0x80, 0x1f, 0xc3, 0xea, 0x03, 0x12, 0x84, 0xae, 0xef, 0x19, 0x0d,
+	0x58, 0x3d, 0x10, 0x9f, 0x52, 0xa8, 0x22, 0xe7, 0x08, 0x6e, 0xc0, 0xaa, 0x43, 0x06, 0x84, 0x12,
+	0x87, 0x8b, 0x2d, 0x5b, 0x8a, 0x4c, 0x4e, 0x59, 0x48, 0x4f, 0x79, 0x1f, 0x36, 0xe4, 0x94, 0xc4,
+	0x99, 0x63, 0xc9, 0x35, 0x28, 0xcb, 0xb9, 0xc3, 0x46, 0x6e, 0x2b, 0x7f, 0xb5, 0xb2, 0xb3, 0xb9,
+	0xad, 0x50, 0xdb, 0x96, 0x83, 0xad, 0x88, 0x05, 0xfb, 0x50, 0xfc, 0xf6, 0xd8, 0xa7, 0xb6, 0x61,
+	0x42, 0x79, 0x68, 0x4f, 0x98, 0xd0, 0x50, 0x8a, 0x8b, 0x68, 0xe3, 0x15, 0xd8, 0x18, 0xda, 0x93,
+	0x07, 0x4c, 0xf5, 0x5b, 0x53, 0x4a, 0x42, 0x6e, 0x4c, 0xde, 0x4a, 0x36, 0x4a, 0xae, 0x9e, 0x4f,
+	0xed, 0x81, 0xe0, 0xca, 0x47, 0x5c, 0x71, 0x23, 0xbe, 0x01, 0xe5, 0xae, 0x67, 0x8f, 0xc2, 0x7d,
+	0x9f, 0xce, 0x78, 0x4d, 0x83, 0x31, 0x97, 0x80, 0x11, 0xbf, 0x0c, 0xb5, 0x77, 0xfc, 0xee, 0xb8,
+	0xbf, 0xdf, 0x0b, 0x08, 0xe9, 0x04, 0x81, 0x1f, 0xa4, 0x07, 0xe3, 0xbb, 0xb0, 0xb9, 0xeb, 0x1d,
+	0xd8, 0x03, 0xd7, 0xe9, 0x31, 0x67, 0x0b, 0xa6, 0x37, 0xa0, 0xd2, 0x8f, 0xc3, 0x84, 0x73, 0x57,
+	0x76, 0x2e, 0xc4, 0x80, 0x68, 0x31, 0x64, 0xe9, 0x9c, 0x18, 0x43, 0x55, 0x4c, 0x78, 0x87, 0x4c,
+	0x85, 0xa8, 0x19, 0xa8, 0xf1, 0x5b, 0x70, 0xe1, 0x0e, 0x99, 0xb6, 0x06, 0x01, 0xb1, 0x9d, 0x69,
+	0x67, 0xe2, 0x86, 0x34, 0x14, 0xac, 0x18, 0x0a, 0x2e, 0x25, 0x43, 0x39, 0x5d, 0x35, 0x9e, 0x8e,
+	0xc1, 0x69, 0xf1, 0x3e, 0xfc, 0x05, 0xd8, 0x90, 0x16, 0x4d, 0xc4, 0x20, 0x03, 0x0a, 0x74, 0xb2,
+	0xab, 0x2c, 0xe2, 0xdf, 0xf8, 0xab, 0x50, 0xed, 0x4d, 0x5a, 0x1f, 0xfa, 0x01, 0x25, 0xce, 0x5c,
+	0x2e, 0xe3, 0x22, 0x94, 0x02, 0x62, 0x87, 0xbe, 0x8a, 0x76, 0x49, 0xe1, 0x9b, 0x70, 0x4e, 0x4c,
+	0xa1, 0x00, 0x17, 0x22, 0x9a, 0x00, 0xa1, 0x6c, 0x88, 0x04, 0x69, 0x2d, 0xf8, 0xcb, 0x50, 0xbd,
+	0x43, 0xa6, 0x77, 0xfd, 0xfe, 0x23, 0xe2, 0xcc, 0x31, 0x3d, 0x52, 0x23, 0xa7, 0x29, 0x7b, 0x1d,
+	0x2e, 0xb4, 0x89, 0xed, 0x0c, 0x5c, 0x8f, 0x74, 0x26, 0x7d, 0x42, 0x1c, 0x35, 0xdc, 0x84, 0xb2,
+	0x23, 0x3b, 0x54, 0x68, 0x29, 0x1a, 0x8f, 0xc0, 0xe0, 0xf1, 0x97, 0x1c, 0x71, 0x1e, 0x8a, 0x03,
+	0x77, 0xe8, 0x52, 0xce, 0xbe, 0x66, 0x09, 0x82, 0xa9, 0x31, 0xb4, 0x27, 0x72, 0x4e, 0xf6, 0xc9,
+	0x56, 0x4b, 0x40, 0x1e, 0x8f, 0x49, 0xa8, 0x56, 0x52, 0xde, 0x8a, 0x1b, 0x98, 0x94, 0xb0, 0xef,
+	0x8f, 0x08, 0x5f, 0x47, 0x6b, 0x96, 0x20, 0xf0, 0xfb, 0x50, 0xb7, 0x6c, 0x4a, 0xee, 0x32, 0x91,
+	0xda, 0x7c, 0x82, 0x13, 0x69, 0x9c, 0xca, 0x6c, 0x01, 0x2a, 0x37, 0xbb, 0x09, 0x10, 0x10, 0x1a,
+	0x4c, 0x5b, 0x0f, 0x29, 0x09, 0xe4, 0x84, 0x5a, 0x0b, 0x6e, 0x41, 0xed, 0xdd, 0x03, 0x12, 0x0c,
+	0x7c, 0xdb, 0xd1, 0x1c, 0xe6, 0xf9, 0x8e, 0x92, 0xcc, 0xbf, 0x19, 0x20, 0x7d, 0x7b, 0x64, 0xf7,
+	0x5d, 0x3a, 0x95, 0xd6, 0x44, 0x34, 0x3e, 0x07, 0x9b, 0xdd, 0xfd, 0x31, 0xa5, 0xae, 0xb7, 0xd7,
+	0xf6, 0x9f, 0x88, 0x30, 0xc6, 0xd7, 0xe0, 0x9c, 0x8c, 0x6d, 0x4b, 0x58, 0x27, 0x64, 0xc7, 0x8e,
+	0x47, 0x09, 0xc7, 0xff, 0x01, 0xc1, 0xe6, 0xed, 0x80, 0xd8, 0x94, 0xb0, 0xe5, 0x22, 0x87, 0xb0,
+	0xd5, 0x35, 0xb4, 0x27, 0x5d, 0xf7, 0xfb, 0xca, 0x0b, 0x8a, 0x64, 0x86, 0x52, 0x3a, 0x50, 0xc0,
+	0x52, 0x3a, 0x30, 0xb6, 0xa0, 0xe2, 0x3a, 0x03, 0xd2, 0x73, 0x87, 0xc4, 0x1f, 0xab, 0xbd, 0x4f,
+	0x6f, 0xd2, 0xa0, 0xdf, 0x75, 0x24, 0xc0, 0x71, 0x43, 0xc2, 0xe5, 0xc5, 0xa4, 0xcb, 0x8d, 0x57,
+	0xa1, 0xf8, 0x98, 0xb9, 0xbc, 0x51, 0xe2, 0xcb, 0xa3, 0x16, 0x2f, 0x0f, 0x1e, 0x09, 0x96, 0xe8,
+	0xc5, 0xf7, 0xc0, 0xd0, 0x6d, 0x08, 0x47, 0xbe, 0x17, 0x92, 0xd3, 0x2f, 0xe8, 0x1f, 0x21, 0xa8,
+	0x31, 0x49, 0xbb, 0xde, 0x43, 0x5f, 0x21, 0x72, 0x5a, 0x61, 0x49, 0xe3, 0x73, 0x8b, 0x8c, 0xcf,
+	0xa7, 0xe2, 0xfd, 0xdf, 0x08, 0xea, 0xb1, 0x1a, 0x4b, 0x1a, 0xa5, 0xbb, 0x34, 0x97, 0x74, 0xe9,
+	0xc2, 0xa3, 0x2b, 0xed, 0xde, 0xc2, 0xac, 0x7b, 0x23, 0x27, 0x15, 0x17, 0x39, 0x89, 0x2d, 0x1c,
+	0x97, 0x1f, 0x19, 0x25, 0x2e, 0x42, 0x10, 0xac, 0xf5, 0x43, 0x7e, 0x02, 0xac, 0x8a, 0x56, 0x4e,
+	0xe0, 0xdf, 0x22, 0xa8, 0xdf, 0x1e, 0xf8, 0x5e, 0x22, 0x28, 0x4f, 0x6d, 0xfa, 0x7f, 0x35, 0x66,
+	0xf1, 0x2f, 0xd8, 0x8a, 0x8a, 0x75, 0x97, 0x7e, 0xbb, 0x06, 0xa5, 0xd0, 0x1f, 0x07, 0x7d, 0xb2,
+	0x58, 0x6f, 0xc9, 0x94, 0xb6, 0x35, 0x77, 0x92, 0x70, 0x63, 0xc0, 0xde, 0xf6, 0xc7, 0x5e, 0xe4,
+	0xcc, 0xa8, 0x01, 0xff, 0x0e, 0xc1, 0xf9, 0x2e, 0xa1, 0xfc, 0x64, 0x64, 0x1e, 0x9e, 0xfe, 0x9f,
+	0x61, 0xfb, 0x31, 0x82, 0x0b, 0x29, 0xfd, 0x97, 0x5d, 0x17, 0x89, 0xe8, 0xcf, 0x1d, 0x11, 0xfd,
+	0xb3, 0xc6, 0xe0, 0x1f, 0x23, 0xd8, 0x6c, 0xf3, 0x8c, 0xec, 0x4c, 0x62, 0xf5, 0xf4, 0xdb, 0xc5,
+	0x3d, 0x30, 0x74, 0x3d, 0x96, 0xdd, 0x04, 0x7f, 0x9e, 0x83, 0x8d, 0x5d, 0x2f, 0x24, 0x01, 0x5d,
+	0xda, 0x26, 0x95, 0xe3, 0xe4, 0xe6, 0xe7, 0x38, 0x7a, 0x3e, 0x97, 0x4f, 0xa6, 0xc5, 0x32, 0xc2,
+	0x0a, 0x71, 0x84, 0x25, 0x5c, 0x56, 0x4c, 0xbb, 0x2c, 0x81, 0x60, 0x69, 0x11, 0x82, 0xab, 0xa9,
+	0xd3, 0xe6, 0x8b, 0x50, 0x75, 0x1d, 0x32, 0x1c, 0xf9, 0x94, 0x78, 0xfd, 0xe9, 0x1d, 0x32, 0x6d,
+	0x94, 0xf9, 0xf0, 0x54, 0x2b, 0xbe, 0x01, 0x55, 0x85, 0x8c, 0x44, 0xf9, 0x18, 0x16, 0xe2, 0x7f,
+	0x21, 0x30, 0xc4, 0xb0, 0x5b, 0x36, 0xed, 0xef, 0x2f, 0x8d, 0xea, 0x2b, 0x6a, 0x3f, 0x15, 0xa9,
+	0x7b, 0x7a, 0x52, 0xd1, 0xb9, 0x00, 0xd7, 0xd3, 0x9f, 0xca, 0xb3, 0x38, 0x95, 0x32, 0x71, 0x1a,
+	0xc3, 0xb9, 0x84, 0xc1, 0x12, 0x2c, 0x13, 0xca, 0x2e, 0x6f, 0x26, 0x2a, 0xa5, 0x8c, 0x68, 0xe3,
+	0x35, 0x28, 0x13, 0x96, 0x1d, 0xbb, 0xde, 0xde, 0x1c, 0xbb, 0xa2, 0x7e, 0x96, 0xd2, 0x0c, 0x78,
+	0xe6, 0xd9, 0xc8, 0x6f, 0xe5, 0xaf, 0xe6, 0x2d, 0x49, 0xe1, 0xbf, 0x21, 0xd8, 0x10, 0x2b, 0xe1,
+	0x2c, 0x76, 0x37, 0x95, 0xd6, 0xc9, 0x6c, 0xf6, 0x45, 0xe2, 0x79, 0x03, 0xaa, 0xca, 0xae, 0x54,
+	0xdc, 0x2d, 0xba, 0x3d, 0xfc, 0x11, 0xc1, 0x46, 0x97, 0xd8, 0x41, 0x7f, 0xff, 0x33, 0x80, 0x63,
+	0x1b, 0xca, 0xea, 0x3a, 0xc0, 0xf1, 0xa8, 0xec, 0x18, 0xb1, 0x1c, 0x75, 0x97, 0xb0, 0x22, 0x9e,
+	0x25, 0xb6, 0xfe, 0x1b, 0x50, 0x55, 0x56, 0x9c, 0xc0, 0xf8, 0xdf, 0xf0, 0x54, 0xce, 0x66, 0x3e,
+	0x5a, 0x3e, 0x1a, 0x74, 0x63, 0x73, 0x27, 0x35, 0x36, 0xbf, 0xc8, 0xd8, 0x42, 0xca, 0xd8, 0x37,
+	0xa1, 0x1e, 0x6b, 0x2d, 0xcd, 0x8d, 0xd6, 0x3b, 0x5a, 0xb0, 0xde, 0xf1, 0x5f, 0x11, 0xac, 0x5b,
+	0xb6, 0xb7, 0xb7, 0xbc, 0xb5, 0x06, 0x14, 0x1e, 0x06, 0xfe, 0x50, 0xdd, 0xdb, 0xd8, 0x37, 0xbb,
+	0x48, 0x53, 0x5f, 0x06, 0x7e, 0x8e, 0xfa, 0x09, 0x44, 0x0a, 0x27, 0x45, 0xa4, 0xb8, 0x08, 0x91,
+	0x52, 0x0a, 0x91, 0x9b, 0xb0, 0x21, 0xcd, 0x3a, 0x11, 0x1c, 0x2c, 0x95, 0x8f, 0xf4, 0x78, 0x71,
+	0x67, 0xf3, 0x23, 0xa8, 0xc7, 0x5a, 0x2c, 0x9b, 0xb1, 0x24, 0x2f, 0xe5, 0xb9, 0x99, 0x4b, 0xf9,
+	0xaf, 0x11, 0x5c, 0xb4, 0xc8, 0x80, 0xd8, 0x21, 0x39, 0x33, 0xd3, 0x8f, 0x98, 0x73, 0x89, 0x50,
+	0xff, 0x0a, 0x5c, 0x9a, 0x51, 0x56, 0x22, 0x74, 0x54, 0xf5, 0xe1, 0x0e, 0xd4, 0x5a, 0x7d, 0xea,
+	0x1e, 0x44, 0x23, 0x43, 0x36, 0x53, 0x54, 0xd2, 0x42, 0xfc, 0x54, 0x88, 0xe8, 0xc5, 0xee, 0xc3,
+	0xdf, 0x85, 0xf5, 0xfb, 0xe3, 0x60, 0x4f, 0xe4, 0x95, 0xc4, 0x59, 0x50, 0xa7, 0xab, 0x43, 0xde,
+	0xf3, 0x9f, 0xa8, 0x5d, 0xd0, 0xf3, 0x9f, 0x2c, 0xb6, 0x1e, 0xff, 0x04, 0x41, 0xa5, 0x37, 0x79,
+	0x77, 0x44, 0x02, 0x9b, 0xb2, 0xf1, 0xdb, 0x50, 0xa0, 0x53, 0x59, 0x40, 0xa8, 0xee, 0x98, 0x31,
+	0xfa, 0x1a, 0xd3, 0x76, 0x6f, 0x3a, 0x22, 0x16, 0xe7, 0x3b, 0x56, 0x72, 0xf1, 0x1a, 0x14, 0xd8,
+	0x08, 0x03, 0xa0, 0xb4, 0xfb, 0x4e, 0xb7, 0x63, 0xf5, 0xea, 0x2b, 0xec, 0xbb, 0xdd, 0xb9, 0xdb,
+	0xe9, 0x75, 0xea, 0x88, 0x7d, 0xbf, 0x77, 0xbf, 0xdd, 0xea, 0x75, 0xea, 0x39, 0xfc, 0x43, 0x04,
+	0xd5, 0x5b, 0x64, 0xcf, 0xf5, 0x7a, 0x93, 0x17, 0xb8, 0x24, 0x3e, 0x80, 0x5a, 0xa4, 0xc4, 0xb2,
+	0x2b, 0x22, 0xab, 0xc4, 0xf4, 0x67, 0x04, 0xd5, 0x2e, 0xb5, 0xf7, 0xc8, 0x19, 0x58, 0x99, 0x21,
+	0xdf, 0xb8, 0x0e, 0x6b, 0xbe, 0xf2, 0x56, 0x23, 0x9f, 0x16, 0xa5, 0xb9, 0xd2, 0x8a, 0xf9, 0x96,
+	0x38, 0xfe, 0xde, 0x87, 0x5a, 0x64, 0x8d, 0x84, 0x2b, 0xab, 0xbe, 0x97, 0xd0, 0x2a, 0x77, 0x3c,
+	0xad, 0xf0, 0xef, 0x11, 0xd4, 0x6e, 0xfb, 0xc3, 0xa1, 0x4b, 0x3f, 0x23, 0xac, 0x4e, 0xbd, 0x3b,
+	0x64, 0xa4, 0x46, 0xc5, 0xcc, 0xd4, 0xe8, 0x7b, 0x50, 0x8f, 0x2d, 0x58, 0x80, 0xcf, 0x4d, 0x80,
+	0xc8, 0x6e, 0x95, 0x39, 0xcf, 0x01, 0x48, 0x63, 0xc4, 0x3f, 0x43, 0x50, 0xe5, 0xb5, 0xd5, 0xff,
+	0x35, 0x80, 0xf0, 0xab, 0x50, 0x8b, 0x14, 0x9b, 0x6f, 0x37, 0x0e, 0x60, 0xad, 0x37, 0xb9, 0x1f,
+	0x90, 0x91, 0x1d, 0x9c, 0x5d, 0xe0, 0x1c, 0xb1, 0xef, 0x7d, 0x00, 0xa5, 0xde, 0xe4, 0x81, 0x4f,
+	0xb3, 0x27, 0x9c, 0xcd, 0x25, 0x2f, 0x42, 0xa9, 0xcf, 0x7d, 0x28, 0x1f, 0x3a, 0x24, 0xa5, 0x95,
+	0x2e, 0x0b, 0x89, 0xd2, 0x65, 0x1b, 0xea, 0xbd, 0x89, 0x7a, 0xa6, 0x90, 0x5e, 0xc9, 0x9a, 0x69,
+	0xf1, 0xbe, 0xdf, 0x82, 0x4d, 0x4d, 0xca, 0x82, 0xd0, 0x99, 0xff, 0xe2, 0xb0, 0x0f, 0xe5, 0xde,
+	0x44, 0x84, 0xdf, 0x31, 0x4d, 0x3d, 0xe5, 0x2d, 0x02, 0xdf, 0x83, 0x55, 0x59, 0xe4, 0x3f, 0xe6,
+	0x44, 0x8b, 0x3d, 0x74, 0x0d, 0x8a, 0xbd, 0x49, 0xab, 0xff, 0xe8, 0x78, 0xc2, 0xf0, 0x37, 0x60,
+	0xbd, 0x33, 0x19, 0xf9, 0x01, 0xfd, 0x26, 0xb1, 0x1d, 0x12, 0x2c, 0xa8, 0x12, 0x27, 0xaa, 0x50,
+	0xb9, 0x74, 0x15, 0xea, 0x2f, 0x08, 0x2a, 0xf7, 0xc6, 0x03, 0xea, 0x8a, 0xdb, 0xe1, 0xf1, 0x52,
+	0x39, 0xe3, 0x4b, 0x50, 0x64, 0x17, 0x3c, 0xb5, 0x6a, 0xcf, 0xe9, 0xd1, 0x29, 0x23, 0xdb, 0x12,
+	0x1c, 0xc6, 0xd7, 0xa1, 0x7a, 0xa0, 0xbf, 0x7d, 0x85, 0xfc, 0x86, 0x58, 0xd9, 0xb9, 0x34, 0xf3,
+	0xbc, 0x25, 0xfa, 0xad, 0x14, 0x3b, 0xd3, 0x5f, 0xe5, 0x19, 0x61, 0xa3, 0xc0, 0xf3, 0x88, 0xb8,
+	0x81, 0xd5, 0x2c, 0x87, 0xfe, 0x01, 0x11, 0x19, 0x6c, 0xd9, 0x12, 0x04, 0x7e, 0x0f, 0xd6, 0x98,
+	0xed, 0x6d, 0x32, 0xd0, 0x8b, 0x9d, 0x28, 0xb3, 0xd8, 0x99, 0xd3, 0x8a, 0x9d, 0x47, 0xf8, 0xe8,
+	0x23, 0x04, 0xeb, 0xdf, 0x39, 0x93, 0x82, 0xc1, 0xe9, 0xcf, 0xea, 0x9f, 0x22, 0x00, 0xae, 0x43,
+	0xe7, 0x80, 0x78, 0xd4, 0xb8, 0x96, 0x48, 0x60, 0x2e, 0xc7, 0x53, 0xc7, 0x3c, 0x27, 0xcd, 0x5f,
+	0xb6, 0x65, 0xfe, 0xb2, 0x0e, 0x65, 0x91, 0xbf, 0x74, 0xda, 0xf5, 0x15, 0xa3, 0x02, 0xab, 0x22,
+	0x6b, 0x69, 0xd7, 0x11, 0x23, 0x44, 0x3a, 0xd3, 0xae, 0xe7, 0xf0, 0xdf, 0x11, 0x14, 0xba, 0x23,
+	0x9b, 0x3f, 0xa7, 0xd2, 0xc0, 0xee, 0x13, 0x19, 0xbc, 0x6b, 0x96, 0x22, 0xd9, 0xb6, 0x11, 0x8e,
+	0x6c, 0x2f, 0xb2, 0x55, 0x52, 0x06, 0x86, 0x75, 0x16, 0x2b, 0x1e, 0xed, 0x8a, 0x5e, 0x81, 0x78,
+	0xa2, 0x8d, 0xbf, 0xc4, 0xd8, 0x43, 0xf5, 0x1a, 0xc4, 0xbf, 0x99, 0xf3, 0xec, 0x3e, 0xf5, 0x03,
+	0x79, 0x02, 0x09, 0x82, 0xc1, 0xf6, 0x78, 0x4c, 0xc6, 0xc4, 0x69, 0x51, 0x75, 0x67, 0x51, 0x34,
+	0x8f, 0x22, 0x6a, 0x07, 0x94, 0x77, 0x8a, 0x62, 0x53, 0xdc, 0xc0, 0x34, 0x27, 0x9e, 0xc3, 0xfb,
+	0xca, 0x62, 0xf5, 0x48, 0x12, 0xff, 0x12, 0xc1, 0x7a, 0x8f, 0x59, 0xa1, 0x3d, 0xc7, 0xcc, 0x31,
+	0xf2, 0xd4, 0x3e, 0x4d, 0x87, 0x51, 0xe1, 0xd8, 0x85, 0xc1, 0x9b, 0xb0, 0x21, 0x95, 0x8b, 0x6f,
+	0x62, 0x0c, 0xda, 0x8c, 0xe5, 0xcb, 0xd0, 0xb4, 0x44, 0xe7, 0xce, 0x47, 0x00, 0x95, 0x5e, 0x40,
+	0x48, 0x97, 0x04, 0x07, 0x6e, 0x9f, 0x18, 0x6f, 0x03, 0xc4, 0x6f, 0x36, 0xc6, 0x4b, 0x89, 0x89,
+	0x93, 0xaf, 0x51, 0xe6, 0x95, 0xec, 0x4e, 0x39, 0x7d, 0x1b, 0xd6, 0xa2, 0x72, 0xbb, 0xa1, 0x65,
+	0xd3, 0xe9, 0xf7, 0x03, 0xf3, 0xa5, 0xcc, 0x3e, 0x29, 0xa5, 0x05, 0x65, 0xf5, 0xd6, 0x62, 0x68,
+	0x11, 0x9d, 0x7a, 0x06, 0x32, 0xcd, 0xac, 0x2e, 0x29, 0xe2, 0x3e, 0x6c, 0x24, 0x6a, 0xd3, 0x46,
+	0x53, 0x43, 0x22, 0xa3, 0xe8, 0x6e, 0x7e, 0x7e, 0x6e, 0xbf, 0x94, 0xf8, 0x36, 0x40, 0x5c, 0xd2,
+	0xd5, 0x31, 0x9a, 0x29, 0x38, 0x9b, 0x57, 0xb2, 0x3b, 0xa5, 0xa0, 0xb7, 0xa0, 0x24, 0xf7, 0x5a,
+	0x6d, 0x0b, 0x4c, 0x54, 0x77, 0xcd, 0xc6, 0x6c, 0x87, 0x1c, 0xfc, 0x2d, 0xa8, 0x68, 0x65, 0x3c,
+	0xe3, 0x4a, 0x9a, 0x51, 0x2f, 0x67, 0x9a, 0x9f, 0x9b, 0xd3, 0x1b, 0x2b, 0x22, 0xaa, 0x38, 0xba,
+	0x22, 0x89, 0xea, 0x94, 0xd9, 0x98, 0xed, 0x88, 0x07, 0x0b, 0xdb, 0xf4, 0xc1, 0x89, 0x4a, 0x9f,
+	0xd9, 0x98, 0xed, 0x90, 0x83, 0xdf, 0x84, 0x22, 0x2f, 0x20, 0x18, 0x17, 0x63, 0x16, 0xbd, 0x50,
+	0x62, 0x5e, 0x9a, 0x69, 0x8f, 0x43, 0x23, 0xfa, 0x0d, 0xe1, 0x72, 0x46, 0x79, 0x63, 0x36, 0x34,
+	0x66, 0x6e, 0xb2, 0x0f, 0xa0, 0x96, 0xba, 0xe4, 0x1a, 0x5b, 0xda, 0x74, 0x99, 0x97, 0x75, 0xf3,
+	0xe5, 0x05, 0x1c, 0x52, 0xee, 0xd7, 0x60, 0x55, 0x5e, 0xa2, 0x0c, 0xcd, 0xf2, 0xe4, 0xe5, 0xce,
+	0xbc, 0x9c, 0xd1, 0x13, 0x8f, 0x97, 0xb7, 0x0a, 0x7d, 0x7c, 0xf2, 0xda, 0x64, 0x5e, 0xce, 0xe8,
+	0x89, 0xa1, 0x51, 0x69, 0xb7, 0x0e, 0x4d, 0xea, 0x32, 0x61, 0x9a, 0x59, 0x5d, 0xb1, 0x0a, 0x32,
+	0x81, 0xd5, 0x55, 0x48, 0x26, 0xdb, 0xe6, 0xe5, 0x8c, 0x9e, 0xd8, 0xaf, 0x7c, 0x3b, 0xd2, 0xfd,
+	0xaa, 0x6f, 0x9e, 0xe6, 0xa5, 0x99, 0xf6, 0xe8, 0xba, 0x59, 0x56, 0x45, 0xb6, 0xe4, 0x92, 0x4f,
+	0x94, 0x0b, 0xcd, 0xd4, 0x81, 0xf5, 0x3a, 0x32, 0xde, 0x80, 0x22, 0x3f, 0xe9, 0xf4, 0x29, 0xf5,
+	0x23, 0xda, 0x3c, 0x9f, 0x75, 0x24, 0xbe, 0x8e, 0x6e, 0xdd, 0x78, 0xfa, 0xac, 0xb9, 0xf2, 0xc9,
+	0xb3, 0xe6, 0xca, 0xa7, 0xcf, 0x9a, 0xe8, 0x07, 0x87, 0x4d, 0xf4, 0xab, 0xc3, 0x26, 0xfa, 0xd3,
+	0x61, 0x13, 0x3d, 0x3d, 0x6c, 0xa2, 0x7f, 0x1c, 0x36, 0xd1, 0x3f, 0x0f, 0x9b, 0x2b, 0x9f, 0x1e,
+	0x36, 0xd1, 0xc7, 0xcf, 0x9b, 0x2b, 0x4f, 0x9f, 0x37, 0x57, 0x3e, 0x79, 0xde, 0x5c, 0xf9, 0xb0,
+	0xc4, 0x7f, 0x68, 0xba, 0xfe, 0x9f, 0x01, 0x00, 0x83, 0x7a, 0xf0, 0x07, 0xde, 0x24, 0x00, 0x00,
+}
+
+func (x TxOperation_Type) String() string {
+	s, ok := TxOperation_Type_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x WatchEvent_Type) String() string {
+	s, ok := WatchEvent_Type_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Credentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Credentials)
+	if !ok {
+		that2, ok := that.(Credentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (this *Item) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Item)
+	if !ok {
+		that2, ok := that.(Item)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	return true
+}
+func (this *Version) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Version)
+	if !ok {
+		that2, ok := that.(Version)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	return true
+}
+func (this *VersionedItem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VersionedItem)
+	if !ok {
+		that2, ok := that.(VersionedItem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Versions) != len(that1.Versions) {
+		return false
+	}
+	for i := range this.Versions {
+		if !this.Versions[i].Equal(that1.Versions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Quota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Quota)
+	if !ok {
+		that2, ok := that.(Quota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxItems != that1.MaxItems {
+		return false
+	}
+	if this.MaxValueBytes != that1.MaxValueBytes {
+		return false
+	}
+	if this.MaxTotalBytes != that1.MaxTotalBytes {
+		return false
+	}
+	return true
+}
+func (this *Snapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Snapshot)
+	if !ok {
+		that2, ok := that.(Snapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *NoSuchTreeError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NoSuchTreeError)
+	if !ok {
+		that2, ok := that.(NoSuchTreeError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
+}
+func (this *InvalidTokenError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InvalidTokenError)
+	if !ok {
+		that2, ok := that.(InvalidTokenError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	return true
+}
+func (this *NoSuchKeyError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NoSuchKeyError)
+	if !ok {
+		that2, ok := that.(NoSuchKeyError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
+}
+func (this *KeyAlreadyExistsError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyAlreadyExistsError)
+	if !ok {
+		that2, ok := that.(KeyAlreadyExistsError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Item.Equal(that1.Item) {
+		return false
+	}
+	return true
+}
+func (this *NoSuchTxError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NoSuchTxError)
+	if !ok {
+		that2, ok := that.(NoSuchTxError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	return true
+}
+func (this *TxAbortedError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxAbortedError)
+	if !ok {
+		that2, ok := that.(TxAbortedError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *NoSuchSnapshotError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NoSuchSnapshotError)
+	if !ok {
+		that2, ok := that.(NoSuchSnapshotError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SnapshotId != that1.SnapshotId {
+		return false
+	}
+	return true
+}
+func (this *KeyLockedError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyLockedError)
+	if !ok {
+		that2, ok := that.(KeyLockedError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	return true
+}
+func (this *DeadlineExceededError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeadlineExceededError)
+	if !ok {
+		that2, ok := that.(DeadlineExceededError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *QuotaExceededError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QuotaExceededError)
+	if !ok {
+		that2, ok := that.(QuotaExceededError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if this.Requested != that1.Requested {
+		return false
+	}
+	if this.Scope != that1.Scope {
+		return false
+	}
+	return true
+}
+func (this *RateLimitedError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RateLimitedError)
+	if !ok {
+		that2, ok := that.(RateLimitedError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Scope != that1.Scope {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.RetryAfter != that1.RetryAfter {
+		return false
+	}
+	return true
+}
+func (this *OverloadedError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OverloadedError)
+	if !ok {
+		that2, ok := that.(OverloadedError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Node != that1.Node {
+		return false
+	}
+	if this.Capacity != that1.Capacity {
+		return false
+	}
+	return true
+}
+func (this *ShuttingDownError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShuttingDownError)
+	if !ok {
+		that2, ok := that.(ShuttingDownError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *InvalidRequestError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InvalidRequestError)
+	if !ok {
+		that2, ok := that.(InvalidRequestError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *CreateTreeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateTreeRequest)
+	if !ok {
+		that2, ok := that.(CreateTreeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxSize != that1.MaxSize {
+		return false
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	if this.IdleTimeout != that1.IdleTimeout {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if !this.Quota.Equal(that1.Quota) {
+		return false
+	}
+	return true
+}
+func (this *CreateTreeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateTreeResponse)
+	if !ok {
+		that2, ok := that.(CreateTreeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	return true
+}
+func (this *TreeInfoRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreeInfoRequest)
+	if !ok {
+		that2, ok := that.(TreeInfoRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *TreeInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TreeInfoResponse)
+	if !ok {
+		that2, ok := that.(TreeInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.MaxSize != that1.MaxSize {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if this.IdleTimeout != that1.IdleTimeout {
+		return false
+	}
+	if !this.Quota.Equal(that1.Quota) {
+		return false
+	}
+	if this.Items != that1.Items {
+		return false
+	}
+	if this.Bytes != that1.Bytes {
+		return false
+	}
+	return true
+}
+func (this *CloneTreeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CloneTreeRequest)
+	if !ok {
+		that2, ok := that.(CloneTreeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	if this.IdleTimeout != that1.IdleTimeout {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *CloneTreeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CloneTreeResponse)
+	if !ok {
+		that2, ok := that.(CloneTreeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Source.Equal(that1.Source) {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.ItemCount != that1.ItemCount {
+		return false
+	}
+	return true
+}
+func (this *SetTreeExpiryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetTreeExpiryRequest)
+	if !ok {
+		that2, ok := that.(SetTreeExpiryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	if this.IdleTimeout != that1.IdleTimeout {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *SetTreeExpiryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetTreeExpiryResponse)
+	if !ok {
+		that2, ok := that.(SetTreeExpiryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if this.IdleTimeout != that1.IdleTimeout {
+		return false
+	}
+	return true
+}
+func (this *DeleteTreeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteTreeRequest)
+	if !ok {
+		that2, ok := that.(DeleteTreeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *DeleteTreeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteTreeResponse)
+	if !ok {
+		that2, ok := that.(DeleteTreeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	return true
+}
+func (this *InsertRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InsertRequest)
+	if !ok {
+		that2, ok := that.(InsertRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if !this.Item.Equal(that1.Item) {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Ttl != that1.Ttl {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
+	return true
+}
+func (this *InsertResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InsertResponse)
+	if !ok {
+		that2, ok := that.(InsertResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Item.Equal(that1.Item) {
+		return false
+	}
+	return true
+}
+func (this *InsertBatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InsertBatchRequest)
+	if !ok {
+		that2, ok := that.(InsertBatchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
+	return true
+}
+func (this *InsertBatchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InsertBatchResponse)
+	if !ok {
+		that2, ok := that.(InsertBatchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Inserted != that1.Inserted {
+		return false
+	}
+	if len(this.Existing) != len(that1.Existing) {
+		return false
+	}
+	for i := range this.Existing {
+		if !this.Existing[i].Equal(that1.Existing[i]) {
+			return false
+		}
+	}
+	if len(this.Locked) != len(that1.Locked) {
+		return false
+	}
+	for i := range this.Locked {
+		if this.Locked[i] != that1.Locked[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DeleteRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteRequest)
+	if !ok {
+		that2, ok := that.(DeleteRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
+	return true
+}
+func (this *DeleteResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteResponse)
+	if !ok {
+		that2, ok := that.(DeleteResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Item.Equal(that1.Item) {
+		return false
+	}
+	return true
+}
+func (this *SearchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchRequest)
+	if !ok {
+		that2, ok := that.(SearchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if !this.Snapshot.Equal(that1.Snapshot) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *SearchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchResponse)
+	if !ok {
+		that2, ok := that.(SearchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Item.Equal(that1.Item) {
+		return false
+	}
+	return true
+}
+func (this *TraverseRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TraverseRequest)
+	if !ok {
+		that2, ok := that.(TraverseRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if !this.Snapshot.Equal(that1.Snapshot) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *TraverseResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TraverseResponse)
+	if !ok {
+		that2, ok := that.(TraverseResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RangeRequest)
+	if !ok {
+		that2, ok := that.(RangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if !this.Snapshot.Equal(that1.Snapshot) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *RangeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RangeResponse)
+	if !ok {
+		that2, ok := that.(RangeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SnapshotRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotRequest)
+	if !ok {
+		that2, ok := that.(SnapshotRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *SnapshotResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotResponse)
+	if !ok {
+		that2, ok := that.(SnapshotResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.SnapshotId != that1.SnapshotId {
+		return false
+	}
+	return true
+}
+func (this *ReleaseSnapshotRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReleaseSnapshotRequest)
+	if !ok {
+		that2, ok := that.(ReleaseSnapshotRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.SnapshotId != that1.SnapshotId {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *ReleaseSnapshotResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReleaseSnapshotResponse)
+	if !ok {
+		that2, ok := that.(ReleaseSnapshotResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SnapshotId != that1.SnapshotId {
+		return false
+	}
+	return true
+}
+func (this *ActiveSnapshots) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActiveSnapshots)
+	if !ok {
+		that2, ok := that.(ActiveSnapshots)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Versions) != len(that1.Versions) {
+		return false
+	}
+	for i := range this.Versions {
+		if this.Versions[i] != that1.Versions[i] {
+			return false
+		}
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *PurgeExpired) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PurgeExpired)
+	if !ok {
+		that2, ok := that.(PurgeExpired)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Now != that1.Now {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *TxOperation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxOperation)
+	if !ok {
+		that2, ok := that.(TxOperation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.Item.Equal(that1.Item) {
+		return false
+	}
+	return true
+}
+func (this *BeginTxRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BeginTxRequest)
+	if !ok {
+		that2, ok := that.(BeginTxRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *BeginTxResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BeginTxResponse)
+	if !ok {
+		that2, ok := that.(BeginTxResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	return true
+}
+func (this *StageTxRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StageTxRequest)
+	if !ok {
+		that2, ok := that.(StageTxRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if !this.Operation.Equal(that1.Operation) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *StageTxResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StageTxResponse)
+	if !ok {
+		that2, ok := that.(StageTxResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if !this.Operation.Equal(that1.Operation) {
+		return false
+	}
+	return true
+}
+func (this *CommitTxRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommitTxRequest)
+	if !ok {
+		that2, ok := that.(CommitTxRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if this.IdempotencyKey != that1.IdempotencyKey {
+		return false
+	}
+	return true
+}
+func (this *CommitTxResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommitTxResponse)
+	if !ok {
+		that2, ok := that.(CommitTxResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if len(this.Operations) != len(that1.Operations) {
+		return false
+	}
+	for i := range this.Operations {
+		if !this.Operations[i].Equal(that1.Operations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AbortTxRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AbortTxRequest)
+	if !ok {
+		that2, ok := that.(AbortTxRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *AbortTxResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AbortTxResponse)
+	if !ok {
+		that2, ok := that.(AbortTxResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	return true
+}
+func (this *TxPrepare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxPrepare)
+	if !ok {
+		that2, ok := that.(TxPrepare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if !this.Operation.Equal(that1.Operation) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *TxVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxVote)
+	if !ok {
+		that2, ok := that.(TxVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Commit != that1.Commit {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *TxVersionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxVersionRequest)
+	if !ok {
+		that2, ok := that.(TxVersionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *TxVersionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxVersionResponse)
+	if !ok {
+		that2, ok := that.(TxVersionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *TxCommit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxCommit)
+	if !ok {
+		that2, ok := that.(TxCommit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *TxAbort) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxAbort)
+	if !ok {
+		that2, ok := that.(TxAbort)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *TxAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxAck)
+	if !ok {
+		that2, ok := that.(TxAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TxId != that1.TxId {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
+}
+func (this *ExportHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportHeader)
+	if !ok {
+		that2, ok := that.(ExportHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxSize != that1.MaxSize {
+		return false
+	}
+	if this.ItemCount != that1.ItemCount {
+		return false
+	}
+	return true
+}
+func (this *MultiInsert) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultiInsert)
+	if !ok {
+		that2, ok := that.(MultiInsert)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if !this.Items[i].Equal(that1.Items[i]) {
+			return false
+		}
+	}
+	if len(this.Locks) != len(that1.Locks) {
+		return false
+	}
+	for i := range this.Locks {
+		if !this.Locks[i].Equal(that1.Locks[i]) {
+			return false
+		}
+	}
+	if len(this.VersionedItems) != len(that1.VersionedItems) {
+		return false
+	}
+	for i := range this.VersionedItems {
+		if !this.VersionedItems[i].Equal(that1.VersionedItems[i]) {
+			return false
+		}
+	}
+	if len(this.Snapshots) != len(that1.Snapshots) {
+		return false
+	}
+	for i := range this.Snapshots {
+		if this.Snapshots[i] != that1.Snapshots[i] {
+			return false
+		}
+	}
+	if this.Moved != that1.Moved {
+		return false
+	}
+	return true
+}
+func (this *SizeDelta) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SizeDelta)
+	if !ok {
+		that2, ok := that.(SizeDelta)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Items != that1.Items {
+		return false
+	}
+	if this.Bytes != that1.Bytes {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	return true
+}
+func (this *WatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchRequest)
+	if !ok {
+		that2, ok := that.(WatchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	return true
+}
+func (this *WatchEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WatchEvent)
+	if !ok {
+		that2, ok := that.(WatchEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.Item.Equal(that1.Item) {
+		return false
+	}
+	return true
+}
+func (this *Span) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Span)
+	if !ok {
+		that2, ok := that.(Span)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TraceId != that1.TraceId {
+		return false
+	}
+	if this.SpanId != that1.SpanId {
+		return false
+	}
+	if this.ParentSpanId != that1.ParentSpanId {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Actor != that1.Actor {
+		return false
+	}
+	if this.QueuedAt != that1.QueuedAt {
+		return false
+	}
+	if this.StartedAt != that1.StartedAt {
+		return false
+	}
+	if this.EndedAt != that1.EndedAt {
+		return false
+	}
+	return true
+}
+func (this *TraceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TraceRequest)
+	if !ok {
+		that2, ok := that.(TraceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TraceId != that1.TraceId {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.Deadline != that1.Deadline {
+		return false
+	}
+	if !this.Credentials.Equal(that1.Credentials) {
+		return false
+	}
+	return true
+}
+func (this *TraceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TraceResponse)
+	if !ok {
+		that2, ok := that.(TraceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Spans) != len(that1.Spans) {
+		return false
+	}
+	for i := range this.Spans {
+		if !this.Spans[i].Equal(that1.Spans[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Credentials) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.Credentials{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Item) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.Item{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Version) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.Version{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Deleted: "+fmt.Sprintf("%#v", this.Deleted)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersionedItem) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.VersionedItem{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Versions != nil {
+		s = append(s, "Versions: "+fmt.Sprintf("%#v", this.Versions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Quota) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.Quota{")
+	s = append(s, "MaxItems: "+fmt.Sprintf("%#v", this.MaxItems)+",\n")
+	s = append(s, "MaxValueBytes: "+fmt.Sprintf("%#v", this.MaxValueBytes)+",\n")
+	s = append(s, "MaxTotalBytes: "+fmt.Sprintf("%#v", this.MaxTotalBytes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Snapshot) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.Snapshot{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NoSuchTreeError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.NoSuchTreeError{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InvalidTokenError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.InvalidTokenError{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NoSuchKeyError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.NoSuchKeyError{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyAlreadyExistsError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.KeyAlreadyExistsError{")
+	if this.Item != nil {
+		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NoSuchTxError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.NoSuchTxError{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxAbortedError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.TxAbortedError{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NoSuchSnapshotError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.NoSuchSnapshotError{")
+	s = append(s, "SnapshotId: "+fmt.Sprintf("%#v", this.SnapshotId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyLockedError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.KeyLockedError{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeadlineExceededError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.DeadlineExceededError{")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QuotaExceededError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.QuotaExceededError{")
+	s = append(s, "Limit: "+fmt.Sprintf("%#v", this.Limit)+",\n")
+	s = append(s, "Max: "+fmt.Sprintf("%#v", this.Max)+",\n")
+	s = append(s, "Requested: "+fmt.Sprintf("%#v", this.Requested)+",\n")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RateLimitedError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.RateLimitedError{")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "RetryAfter: "+fmt.Sprintf("%#v", this.RetryAfter)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OverloadedError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.OverloadedError{")
+	s = append(s, "Node: "+fmt.Sprintf("%#v", this.Node)+",\n")
+	s = append(s, "Capacity: "+fmt.Sprintf("%#v", this.Capacity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShuttingDownError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&messages.ShuttingDownError{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InvalidRequestError) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.InvalidRequestError{")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateTreeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.CreateTreeRequest{")
+	s = append(s, "MaxSize: "+fmt.Sprintf("%#v", this.MaxSize)+",\n")
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "IdleTimeout: "+fmt.Sprintf("%#v", this.IdleTimeout)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	if this.Quota != nil {
+		s = append(s, "Quota: "+fmt.Sprintf("%#v", this.Quota)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateTreeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.CreateTreeResponse{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TreeInfoRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.TreeInfoRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TreeInfoResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.TreeInfoResponse{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "MaxSize: "+fmt.Sprintf("%#v", this.MaxSize)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "IdleTimeout: "+fmt.Sprintf("%#v", this.IdleTimeout)+",\n")
+	if this.Quota != nil {
+		s = append(s, "Quota: "+fmt.Sprintf("%#v", this.Quota)+",\n")
+	}
+	s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	s = append(s, "Bytes: "+fmt.Sprintf("%#v", this.Bytes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CloneTreeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.CloneTreeRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "IdleTimeout: "+fmt.Sprintf("%#v", this.IdleTimeout)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CloneTreeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.CloneTreeResponse{")
+	if this.Source != nil {
+		s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	}
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "ItemCount: "+fmt.Sprintf("%#v", this.ItemCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetTreeExpiryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.SetTreeExpiryRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "IdleTimeout: "+fmt.Sprintf("%#v", this.IdleTimeout)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetTreeExpiryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.SetTreeExpiryResponse{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "IdleTimeout: "+fmt.Sprintf("%#v", this.IdleTimeout)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteTreeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.DeleteTreeRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteTreeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.DeleteTreeResponse{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InsertRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&messages.InsertRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	if this.Item != nil {
+		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	}
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Ttl: "+fmt.Sprintf("%#v", this.Ttl)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InsertResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.InsertResponse{")
+	if this.Item != nil {
+		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InsertBatchRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.InsertBatchRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	if this.Items != nil {
+		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	}
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InsertBatchResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.InsertBatchResponse{")
+	s = append(s, "Inserted: "+fmt.Sprintf("%#v", this.Inserted)+",\n")
+	if this.Existing != nil {
+		s = append(s, "Existing: "+fmt.Sprintf("%#v", this.Existing)+",\n")
+	}
+	s = append(s, "Locked: "+fmt.Sprintf("%#v", this.Locked)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.DeleteRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.DeleteResponse{")
+	if this.Item != nil {
+		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SearchRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.SearchRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Snapshot != nil {
+		s = append(s, "Snapshot: "+fmt.Sprintf("%#v", this.Snapshot)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SearchResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.SearchResponse{")
+	if this.Item != nil {
+		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TraverseRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.TraverseRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	if this.Snapshot != nil {
+		s = append(s, "Snapshot: "+fmt.Sprintf("%#v", this.Snapshot)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TraverseResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.TraverseResponse{")
+	if this.Items != nil {
+		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RangeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.RangeRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	if this.Snapshot != nil {
+		s = append(s, "Snapshot: "+fmt.Sprintf("%#v", this.Snapshot)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RangeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.RangeResponse{")
+	if this.Items != nil {
+		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SnapshotRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.SnapshotRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SnapshotResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.SnapshotResponse{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "SnapshotId: "+fmt.Sprintf("%#v", this.SnapshotId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReleaseSnapshotRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.ReleaseSnapshotRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "SnapshotId: "+fmt.Sprintf("%#v", this.SnapshotId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReleaseSnapshotResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.ReleaseSnapshotResponse{")
+	s = append(s, "SnapshotId: "+fmt.Sprintf("%#v", this.SnapshotId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActiveSnapshots) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ActiveSnapshots{")
+	s = append(s, "Versions: "+fmt.Sprintf("%#v", this.Versions)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeExpired) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.PurgeExpired{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Now: "+fmt.Sprintf("%#v", this.Now)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxOperation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.TxOperation{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Item != nil {
+		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BeginTxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.BeginTxRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BeginTxResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.BeginTxResponse{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StageTxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.StageTxRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	if this.Operation != nil {
+		s = append(s, "Operation: "+fmt.Sprintf("%#v", this.Operation)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StageTxResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.StageTxResponse{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	if this.Operation != nil {
+		s = append(s, "Operation: "+fmt.Sprintf("%#v", this.Operation)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CommitTxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.CommitTxRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "IdempotencyKey: "+fmt.Sprintf("%#v", this.IdempotencyKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CommitTxResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.CommitTxResponse{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	if this.Operations != nil {
+		s = append(s, "Operations: "+fmt.Sprintf("%#v", this.Operations)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AbortTxRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.AbortTxRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AbortTxResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.AbortTxResponse{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxPrepare) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.TxPrepare{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	if this.Operation != nil {
+		s = append(s, "Operation: "+fmt.Sprintf("%#v", this.Operation)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxVote) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.TxVote{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Commit: "+fmt.Sprintf("%#v", this.Commit)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxVersionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.TxVersionRequest{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxVersionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.TxVersionResponse{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxCommit) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.TxCommit{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxAbort) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.TxAbort{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.TxAck{")
+	s = append(s, "TxId: "+fmt.Sprintf("%#v", this.TxId)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExportHeader) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ExportHeader{")
+	s = append(s, "MaxSize: "+fmt.Sprintf("%#v", this.MaxSize)+",\n")
+	s = append(s, "ItemCount: "+fmt.Sprintf("%#v", this.ItemCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultiInsert) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.MultiInsert{")
+	if this.Items != nil {
+		s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	}
+	if this.Locks != nil {
+		s = append(s, "Locks: "+fmt.Sprintf("%#v", this.Locks)+",\n")
+	}
+	if this.VersionedItems != nil {
+		s = append(s, "VersionedItems: "+fmt.Sprintf("%#v", this.VersionedItems)+",\n")
+	}
+	s = append(s, "Snapshots: "+fmt.Sprintf("%#v", this.Snapshots)+",\n")
+	s = append(s, "Moved: "+fmt.Sprintf("%#v", this.Moved)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SizeDelta) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.SizeDelta{")
+	s = append(s, "Items: "+fmt.Sprintf("%#v", this.Items)+",\n")
+	s = append(s, "Bytes: "+fmt.Sprintf("%#v", this.Bytes)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WatchRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.WatchRequest{")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *WatchEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.WatchEvent{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.Item != nil {
+		s = append(s, "Item: "+fmt.Sprintf("%#v", this.Item)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Span) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&messages.Span{")
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "SpanId: "+fmt.Sprintf("%#v", this.SpanId)+",\n")
+	s = append(s, "ParentSpanId: "+fmt.Sprintf("%#v", this.ParentSpanId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Actor: "+fmt.Sprintf("%#v", this.Actor)+",\n")
+	s = append(s, "QueuedAt: "+fmt.Sprintf("%#v", this.QueuedAt)+",\n")
+	s = append(s, "StartedAt: "+fmt.Sprintf("%#v", this.StartedAt)+",\n")
+	s = append(s, "EndedAt: "+fmt.Sprintf("%#v", this.EndedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TraceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.TraceRequest{")
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "Deadline: "+fmt.Sprintf("%#v", this.Deadline)+",\n")
+	if this.Credentials != nil {
+		s = append(s, "Credentials: "+fmt.Sprintf("%#v", this.Credentials)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TraceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.TraceResponse{")
+	if this.Spans != nil {
+		s = append(s, "Spans: "+fmt.Sprintf("%#v", this.Spans)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTree(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TreeServiceClient is the client API for TreeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TreeServiceClient interface {
+	CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*CreateTreeResponse, error)
+	CloneTree(ctx context.Context, in *CloneTreeRequest, opts ...grpc.CallOption) (*CloneTreeResponse, error)
+	TreeInfo(ctx context.Context, in *TreeInfoRequest, opts ...grpc.CallOption) (*TreeInfoResponse, error)
+	SetTreeExpiry(ctx context.Context, in *SetTreeExpiryRequest, opts ...grpc.CallOption) (*SetTreeExpiryResponse, error)
+	DeleteTree(ctx context.Context, in *DeleteTreeRequest, opts ...grpc.CallOption) (*DeleteTreeResponse, error)
+	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error)
+	InsertBatch(ctx context.Context, in *InsertBatchRequest, opts ...grpc.CallOption) (*InsertBatchResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error)
+	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error)
+	ReleaseSnapshot(ctx context.Context, in *ReleaseSnapshotRequest, opts ...grpc.CallOption) (*ReleaseSnapshotResponse, error)
+	BeginTx(ctx context.Context, in *BeginTxRequest, opts ...grpc.CallOption) (*BeginTxResponse, error)
+	StageTx(ctx context.Context, in *StageTxRequest, opts ...grpc.CallOption) (*StageTxResponse, error)
+	CommitTx(ctx context.Context, in *CommitTxRequest, opts ...grpc.CallOption) (*CommitTxResponse, error)
+	AbortTx(ctx context.Context, in *AbortTxRequest, opts ...grpc.CallOption) (*AbortTxResponse, error)
+	Trace(ctx context.Context, in *TraceRequest, opts ...grpc.CallOption) (*TraceResponse, error)
+	// Streams all items sorted by key
+	Traverse(ctx context.Context, in *TraverseRequest, opts ...grpc.CallOption) (TreeService_TraverseClient, error)
+	// Streams the changes of the tree until the client cancels or the tree is deleted
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (TreeService_WatchClient, error)
+}
+
+type treeServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTreeServiceClient(cc *grpc.ClientConn) TreeServiceClient {
+	return &treeServiceClient{cc}
+}
+
+func (c *treeServiceClient) CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*CreateTreeResponse, error) {
+	out := new(CreateTreeResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/CreateTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) CloneTree(ctx context.Context, in *CloneTreeRequest, opts ...grpc.CallOption) (*CloneTreeResponse, error) {
+	out := new(CloneTreeResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/CloneTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) TreeInfo(ctx context.Context, in *TreeInfoRequest, opts ...grpc.CallOption) (*TreeInfoResponse, error) {
+	out := new(TreeInfoResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/TreeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) SetTreeExpiry(ctx context.Context, in *SetTreeExpiryRequest, opts ...grpc.CallOption) (*SetTreeExpiryResponse, error) {
+	out := new(SetTreeExpiryResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/SetTreeExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) DeleteTree(ctx context.Context, in *DeleteTreeRequest, opts ...grpc.CallOption) (*DeleteTreeResponse, error) {
+	out := new(DeleteTreeResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/DeleteTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*InsertResponse, error) {
+	out := new(InsertResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/Insert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) InsertBatch(ctx context.Context, in *InsertBatchRequest, opts ...grpc.CallOption) (*InsertBatchResponse, error) {
+	out := new(InsertBatchResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/InsertBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) Range(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error) {
+	out := new(RangeResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/Range", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error) {
+	out := new(SnapshotResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) ReleaseSnapshot(ctx context.Context, in *ReleaseSnapshotRequest, opts ...grpc.CallOption) (*ReleaseSnapshotResponse, error) {
+	out := new(ReleaseSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/ReleaseSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) BeginTx(ctx context.Context, in *BeginTxRequest, opts ...grpc.CallOption) (*BeginTxResponse, error) {
+	out := new(BeginTxResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/BeginTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) StageTx(ctx context.Context, in *StageTxRequest, opts ...grpc.CallOption) (*StageTxResponse, error) {
+	out := new(StageTxResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/StageTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) CommitTx(ctx context.Context, in *CommitTxRequest, opts ...grpc.CallOption) (*CommitTxResponse, error) {
+	out := new(CommitTxResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/CommitTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) AbortTx(ctx context.Context, in *AbortTxRequest, opts ...grpc.CallOption) (*AbortTxResponse, error) {
+	out := new(AbortTxResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/AbortTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) Trace(ctx context.Context, in *TraceRequest, opts ...grpc.CallOption) (*TraceResponse, error) {
+	out := new(TraceResponse)
+	err := c.cc.Invoke(ctx, "/messages.TreeService/Trace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *treeServiceClient) Traverse(ctx context.Context, in *TraverseRequest, opts ...grpc.CallOption) (TreeService_TraverseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TreeService_serviceDesc.Streams[0], "/messages.TreeService/Traverse", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &treeServiceTraverseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TreeService_TraverseClient interface {
+	Recv() (*Item, error)
+	grpc.ClientStream
+}
+
+type treeServiceTraverseClient struct {
+	grpc.ClientStream
+}
+
+func (x *treeServiceTraverseClient) Recv() (*Item, error) {
+	m := new(Item)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *treeServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (TreeService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TreeService_serviceDesc.Streams[1], "/messages.TreeService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &treeServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TreeService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type treeServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *treeServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TreeServiceServer is the server API for TreeService service.
+type TreeServiceServer interface {
+	CreateTree(context.Context, *CreateTreeRequest) (*CreateTreeResponse, error)
+	CloneTree(context.Context, *CloneTreeRequest) (*CloneTreeResponse, error)
+	TreeInfo(context.Context, *TreeInfoRequest) (*TreeInfoResponse, error)
+	SetTreeExpiry(context.Context, *SetTreeExpiryRequest) (*SetTreeExpiryResponse, error)
+	DeleteTree(context.Context, *DeleteTreeRequest) (*DeleteTreeResponse, error)
+	Insert(context.Context, *InsertRequest) (*InsertResponse, error)
+	InsertBatch(context.Context, *InsertBatchRequest) (*InsertBatchResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	Range(context.Context, *RangeRequest) (*RangeResponse, error)
+	Snapshot(context.Context, *SnapshotRequest) (*SnapshotResponse, error)
+	ReleaseSnapshot(context.Context, *ReleaseSnapshotRequest) (*ReleaseSnapshotResponse, error)
+	BeginTx(context.Context, *BeginTxRequest) (*BeginTxResponse, error)
+	StageTx(context.Context, *StageTxRequest) (*StageTxResponse, error)
+	CommitTx(context.Context, *CommitTxRequest) (*CommitTxResponse, error)
+	AbortTx(context.Context, *AbortTxRequest) (*AbortTxResponse, error)
+	Trace(context.Context, *TraceRequest) (*TraceResponse, error)
+	// Streams all items sorted by key
+	Traverse(*TraverseRequest, TreeService_TraverseServer) error
+	// Streams the changes of the tree until the client cancels or the tree is deleted
+	Watch(*WatchRequest, TreeService_WatchServer) error
+}
+
+func RegisterTreeServiceServer(s *grpc.Server, srv TreeServiceServer) {
+	s.RegisterService(&_TreeService_serviceDesc, srv)
+}
+
+func _TreeService_CreateTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).CreateTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/CreateTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).CreateTree(ctx, req.(*CreateTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_CloneTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).CloneTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/CloneTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).CloneTree(ctx, req.(*CloneTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_TreeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).TreeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/TreeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).TreeInfo(ctx, req.(*TreeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_SetTreeExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTreeExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).SetTreeExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/SetTreeExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).SetTreeExpiry(ctx, req.(*SetTreeExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_DeleteTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).DeleteTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/DeleteTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).DeleteTree(ctx, req.(*DeleteTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_Insert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).Insert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/Insert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).Insert(ctx, req.(*InsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_InsertBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).InsertBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/InsertBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).InsertBatch(ctx, req.(*InsertBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_Range_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).Range(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/Range",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).Range(ctx, req.(*RangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).Snapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_ReleaseSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).ReleaseSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/ReleaseSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).ReleaseSnapshot(ctx, req.(*ReleaseSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_BeginTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).BeginTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/BeginTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).BeginTx(ctx, req.(*BeginTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_StageTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StageTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).StageTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/StageTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).StageTx(ctx, req.(*StageTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_CommitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).CommitTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/CommitTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).CommitTx(ctx, req.(*CommitTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_AbortTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).AbortTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/AbortTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).AbortTx(ctx, req.(*AbortTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_Trace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TreeServiceServer).Trace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.TreeService/Trace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TreeServiceServer).Trace(ctx, req.(*TraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TreeService_Traverse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TraverseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TreeServiceServer).Traverse(m, &treeServiceTraverseServer{stream})
+}
+
+type TreeService_TraverseServer interface {
+	Send(*Item) error
+	grpc.ServerStream
+}
+
+type treeServiceTraverseServer struct {
+	grpc.ServerStream
+}
+
+func (x *treeServiceTraverseServer) Send(m *Item) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TreeService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TreeServiceServer).Watch(m, &treeServiceWatchServer{stream})
+}
+
+type TreeService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type treeServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *treeServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _TreeService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "messages.TreeService",
+	HandlerType: (*TreeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTree",
+			Handler:    _TreeService_CreateTree_Handler,
+		},
+		{
+			MethodName: "CloneTree",
+			Handler:    _TreeService_CloneTree_Handler,
+		},
+		{
+			MethodName: "TreeInfo",
+			Handler:    _TreeService_TreeInfo_Handler,
+		},
+		{
+			MethodName: "SetTreeExpiry",
+			Handler:    _TreeService_SetTreeExpiry_Handler,
+		},
+		{
+			MethodName: "DeleteTree",
+			Handler:    _TreeService_DeleteTree_Handler,
+		},
+		{
+			MethodName: "Insert",
+			Handler:    _TreeService_Insert_Handler,
+		},
+		{
+			MethodName: "InsertBatch",
+			Handler:    _TreeService_InsertBatch_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _TreeService_Search_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TreeService_Delete_Handler,
+		},
+		{
+			MethodName: "Range",
+			Handler:    _TreeService_Range_Handler,
+		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _TreeService_Snapshot_Handler,
+		},
+		{
+			MethodName: "ReleaseSnapshot",
+			Handler:    _TreeService_ReleaseSnapshot_Handler,
+		},
+		{
+			MethodName: "BeginTx",
+			Handler:    _TreeService_BeginTx_Handler,
+		},
+		{
+			MethodName: "StageTx",
+			Handler:    _TreeService_StageTx_Handler,
+		},
+		{
+			MethodName: "CommitTx",
+			Handler:    _TreeService_CommitTx_Handler,
+		},
+		{
+			MethodName: "AbortTx",
+			Handler:    _TreeService_AbortTx_Handler,
+		},
+		{
+			MethodName: "Trace",
+			Handler:    _TreeService_Trace_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Traverse",
+			Handler:       _TreeService_Traverse_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _TreeService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tree.proto",
+}
+
+func (m *Credentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Credentials) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Id))
+	}
+	if len(m.Token) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	return i, nil
+}
+
+func (m *Item) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Item) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Key != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.ExpiresAt))
+	}
+	return i, nil
+}
+
+func (m *Version) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Version) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.Deleted {
+		dAtA[i] = 0x18
+		i++
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.ExpiresAt))
+	}
+	return i, nil
+}
+
+func (m *VersionedItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionedItem) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Key != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if len(m.Versions) > 0 {
+		for _, msg := range m.Versions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Quota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quota) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxItems != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.MaxItems))
+	}
+	if m.MaxValueBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.MaxValueBytes))
+	}
+	if m.MaxTotalBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.MaxTotalBytes))
+	}
+	return i, nil
+}
+
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Id))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *NoSuchTreeError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoSuchTreeError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Id))
+	}
+	return i, nil
+}
+
+func (m *InvalidTokenError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidTokenError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n1, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *NoSuchKeyError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoSuchKeyError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Key != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	return i, nil
+}
+
+func (m *KeyAlreadyExistsError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAlreadyExistsError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
+		n2, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *NoSuchTxError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoSuchTxError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	return i, nil
+}
+
+func (m *TxAbortedError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxAbortedError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *NoSuchSnapshotError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoSuchSnapshotError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.SnapshotId))
+	}
+	return i, nil
+}
+
+func (m *KeyLockedError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyLockedError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Key != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if m.TxId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	return i, nil
+}
+
+func (m *DeadlineExceededError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadlineExceededError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *QuotaExceededError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaExceededError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limit) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Limit)))
+		i += copy(dAtA[i:], m.Limit)
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Max))
+	}
+	if m.Requested != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Requested))
+	}
+	if len(m.Scope) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Scope)))
+		i += copy(dAtA[i:], m.Scope)
+	}
+	return i, nil
+}
+
+func (m *RateLimitedError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitedError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scope) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Scope)))
+		i += copy(dAtA[i:], m.Scope)
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.RetryAfter != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.RetryAfter))
+	}
+	return i, nil
+}
+
+func (m *OverloadedError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverloadedError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Node) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Node)))
+		i += copy(dAtA[i:], m.Node)
+	}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Capacity))
+	}
+	return i, nil
+}
+
+func (m *ShuttingDownError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShuttingDownError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *InvalidRequestError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvalidRequestError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *CreateTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.MaxSize))
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Ttl))
+	}
+	if m.IdleTimeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.IdleTimeout))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Quota.Size()))
+		n3, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *CreateTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n4, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *TreeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n5, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *TreeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n6, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.MaxSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.MaxSize))
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.ExpiresAt))
+	}
+	if m.IdleTimeout != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.IdleTimeout))
+	}
+	if m.Quota != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Quota.Size()))
+		n7, err := m.Quota.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Items != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Items))
+	}
+	if m.Bytes != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Bytes))
+	}
+	return i, nil
+}
+
+func (m *CloneTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloneTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n8, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Ttl))
+	}
+	if m.IdleTimeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.IdleTimeout))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *CloneTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloneTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Source != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Source.Size()))
+		n9, err := m.Source.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Credentials != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n10, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.ItemCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.ItemCount))
+	}
+	return i, nil
+}
+
+func (m *SetTreeExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTreeExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n11, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Ttl))
+	}
+	if m.IdleTimeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.IdleTimeout))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *SetTreeExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTreeExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n12, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.ExpiresAt))
+	}
+	if m.IdleTimeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.IdleTimeout))
+	}
+	return i, nil
+}
+
+func (m *DeleteTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n13, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *DeleteTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n14, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func (m *InsertRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n15, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.Item != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
+		n16, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	if m.Ttl != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Ttl))
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.ExpiresAt))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	return i, nil
+}
+
+func (m *InsertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
+		n17, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+
+func (m *InsertBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n18, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	return i, nil
+}
+
+func (m *InsertBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsertBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Inserted != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Inserted))
+	}
+	if len(m.Existing) > 0 {
+		for _, msg := range m.Existing {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Locked) > 0 {
+		dAtA20 := make([]byte, len(m.Locked)*10)
+		var j19 int
+		for _, num1 := range m.Locked {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(j19))
+		i += copy(dAtA[i:], dAtA20[:j19])
+	}
+	return i, nil
+}
+
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n21, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.Key != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	return i, nil
+}
+
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
+		n22, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	return i, nil
+}
+
+func (m *SearchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n23, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.Key != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if m.Snapshot != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Snapshot.Size()))
+		n24, err := m.Snapshot.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *SearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Item != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
+		n25, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+
+func (m *TraverseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraverseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n26, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.Snapshot != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Snapshot.Size()))
+		n27, err := m.Snapshot.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *TraverseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraverseResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n28, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.From != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.From))
+	}
+	if m.To != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.To))
+	}
+	if m.Snapshot != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Snapshot.Size()))
+		n29, err := m.Snapshot.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *RangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n30, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *SnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n31, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.SnapshotId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.SnapshotId))
+	}
+	return i, nil
+}
+
+func (m *ReleaseSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n32, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.SnapshotId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.SnapshotId))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *ReleaseSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.SnapshotId))
+	}
+	return i, nil
+}
+
+func (m *ActiveSnapshots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		dAtA34 := make([]byte, len(m.Versions)*10)
+		var j33 int
+		for _, num1 := range m.Versions {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j33++
+			}
+			dAtA34[j33] = uint8(num)
+			j33++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(j33))
+		i += copy(dAtA[i:], dAtA34[:j33])
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *PurgeExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeExpired) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	if m.Now != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Now))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *TxOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxOperation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Type))
+	}
+	if m.Item != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
+		n35, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	return i, nil
+}
+
+func (m *BeginTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n36, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *BeginTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeginTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n37, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.TxId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	return i, nil
+}
+
+func (m *StageTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StageTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n38, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if m.TxId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Operation != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Operation.Size()))
+		n39, err := m.Operation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *StageTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StageTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Operation != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Operation.Size()))
+		n40, err := m.Operation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+
+func (m *CommitTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n41, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if m.TxId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	if len(m.IdempotencyKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.IdempotencyKey)))
+		i += copy(dAtA[i:], m.IdempotencyKey)
+	}
+	return i, nil
+}
+
+func (m *CommitTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if len(m.Operations) > 0 {
+		for _, msg := range m.Operations {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AbortTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbortTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n42, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if m.TxId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *AbortTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbortTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	return i, nil
+}
+
+func (m *TxPrepare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxPrepare) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Operation != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Operation.Size()))
+		n43, err := m.Operation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *TxVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxVote) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if m.Commit {
+		dAtA[i] = 0x18
+		i++
+		if m.Commit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *TxVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *TxVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *TxCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxCommit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Version))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *TxAbort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxAbort) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *TxAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Key))
+	}
+	return i, nil
+}
+
+func (m *ExportHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.MaxSize))
+	}
+	if m.ItemCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.ItemCount))
+	}
+	return i, nil
+}
+
+func (m *MultiInsert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiInsert) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Locks) > 0 {
+		for _, msg := range m.Locks {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.VersionedItems) > 0 {
+		for _, msg := range m.VersionedItems {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		dAtA45 := make([]byte, len(m.Snapshots)*10)
+		var j44 int
+		for _, num1 := range m.Snapshots {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j44++
+			}
+			dAtA45[j44] = uint8(num)
+			j44++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(j44))
+		i += copy(dAtA[i:], dAtA45[:j44])
+	}
+	if m.Moved {
+		dAtA[i] = 0x28
+		i++
+		if m.Moved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SizeDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SizeDelta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Items != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Items))
+	}
+	if m.Bytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Bytes))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n46, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	return i, nil
+}
+
+func (m *WatchEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Type != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Type))
+	}
+	if m.Item != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Item.Size()))
+		n47, err := m.Item.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
+	}
+	return i, nil
+}
+
+func (m *Span) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Span) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.TraceId)))
+		i += copy(dAtA[i:], m.TraceId)
+	}
+	if len(m.SpanId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.SpanId)))
+		i += copy(dAtA[i:], m.SpanId)
+	}
+	if len(m.ParentSpanId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.ParentSpanId)))
+		i += copy(dAtA[i:], m.ParentSpanId)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Actor) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.Actor)))
+		i += copy(dAtA[i:], m.Actor)
+	}
+	if m.QueuedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.QueuedAt))
+	}
+	if m.StartedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.StartedAt))
+	}
+	if m.EndedAt != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.EndedAt))
+	}
+	return i, nil
+}
+
+func (m *TraceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.TraceId)))
+		i += copy(dAtA[i:], m.TraceId)
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Deadline != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Deadline))
+	}
+	if m.Credentials != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTree(dAtA, i, uint64(m.Credentials.Size()))
+		n48, err := m.Credentials.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	return i, nil
+}
+
+func (m *TraceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spans) > 0 {
+		for _, msg := range m.Spans {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTree(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintTree(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Credentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTree(uint64(m.Id))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *Item) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTree(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *Version) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTree(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *VersionedItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Quota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxItems != 0 {
+		n += 1 + sovTree(uint64(m.MaxItems))
+	}
+	if m.MaxValueBytes != 0 {
+		n += 1 + sovTree(uint64(m.MaxValueBytes))
+	}
+	if m.MaxTotalBytes != 0 {
+		n += 1 + sovTree(uint64(m.MaxTotalBytes))
+	}
+	return n
+}
+
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTree(uint64(m.Id))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *NoSuchTreeError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTree(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *InvalidTokenError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *NoSuchKeyError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	return n
+}
+
+func (m *KeyAlreadyExistsError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *NoSuchTxError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	return n
+}
+
+func (m *TxAbortedError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *NoSuchSnapshotError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		n += 1 + sovTree(uint64(m.SnapshotId))
+	}
+	return n
+}
+
+func (m *KeyLockedError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	return n
+}
+
+func (m *DeadlineExceededError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *QuotaExceededError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Limit)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Max != 0 {
+		n += 1 + sovTree(uint64(m.Max))
+	}
+	if m.Requested != 0 {
+		n += 1 + sovTree(uint64(m.Requested))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitedError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.RetryAfter != 0 {
+		n += 1 + sovTree(uint64(m.RetryAfter))
+	}
+	return n
+}
+
+func (m *OverloadedError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovTree(uint64(m.Capacity))
+	}
+	return n
+}
+
+func (m *ShuttingDownError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *InvalidRequestError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		n += 1 + sovTree(uint64(m.MaxSize))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovTree(uint64(m.Ttl))
+	}
+	if m.IdleTimeout != 0 {
+		n += 1 + sovTree(uint64(m.IdleTimeout))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TreeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *TreeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovTree(uint64(m.MaxSize))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTree(uint64(m.ExpiresAt))
+	}
+	if m.IdleTimeout != 0 {
+		n += 1 + sovTree(uint64(m.IdleTimeout))
+	}
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Items != 0 {
+		n += 1 + sovTree(uint64(m.Items))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovTree(uint64(m.Bytes))
+	}
+	return n
+}
+
+func (m *CloneTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovTree(uint64(m.Ttl))
+	}
+	if m.IdleTimeout != 0 {
+		n += 1 + sovTree(uint64(m.IdleTimeout))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *CloneTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.ItemCount != 0 {
+		n += 1 + sovTree(uint64(m.ItemCount))
+	}
+	return n
+}
+
+func (m *SetTreeExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovTree(uint64(m.Ttl))
+	}
+	if m.IdleTimeout != 0 {
+		n += 1 + sovTree(uint64(m.IdleTimeout))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *SetTreeExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTree(uint64(m.ExpiresAt))
+	}
+	if m.IdleTimeout != 0 {
+		n += 1 + sovTree(uint64(m.IdleTimeout))
+	}
+	return n
+}
+
+func (m *DeleteTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *DeleteTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovTree(uint64(m.Ttl))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTree(uint64(m.ExpiresAt))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *InsertBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Inserted != 0 {
+		n += 1 + sovTree(uint64(m.Inserted))
+	}
+	if len(m.Existing) > 0 {
+		for _, e := range m.Existing {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	if len(m.Locked) > 0 {
+		l = 0
+		for _, e := range m.Locked {
+			l += sovTree(uint64(e))
+		}
+		n += 1 + sovTree(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *SearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TraverseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *TraverseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovTree(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovTree(uint64(m.To))
+	}
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *RangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *SnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.SnapshotId != 0 {
+		n += 1 + sovTree(uint64(m.SnapshotId))
+	}
+	return n
+}
+
+func (m *ReleaseSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.SnapshotId != 0 {
+		n += 1 + sovTree(uint64(m.SnapshotId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *ReleaseSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotId != 0 {
+		n += 1 + sovTree(uint64(m.SnapshotId))
+	}
+	return n
+}
+
+func (m *ActiveSnapshots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		l = 0
+		for _, e := range m.Versions {
+			l += sovTree(uint64(e))
+		}
+		n += 1 + sovTree(uint64(l)) + l
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *PurgeExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	if m.Now != 0 {
+		n += 1 + sovTree(uint64(m.Now))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TxOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTree(uint64(m.Type))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *BeginTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *BeginTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	return n
+}
+
+func (m *StageTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *StageTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	l = len(m.IdempotencyKey)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AbortTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *AbortTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	return n
+}
+
+func (m *TxPrepare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Operation != nil {
+		l = m.Operation.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TxVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	if m.Commit {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TxVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TxVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *TxCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTree(uint64(m.Version))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TxAbort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TxAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovTree(uint64(m.TxId))
+	}
+	if m.Key != 0 {
+		n += 1 + sovTree(uint64(m.Key))
+	}
+	return n
+}
+
+func (m *ExportHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSize != 0 {
+		n += 1 + sovTree(uint64(m.MaxSize))
+	}
+	if m.ItemCount != 0 {
+		n += 1 + sovTree(uint64(m.ItemCount))
+	}
+	return n
+}
+
+func (m *MultiInsert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	if len(m.VersionedItems) > 0 {
+		for _, e := range m.VersionedItems {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		l = 0
+		for _, e := range m.Snapshots {
+			l += sovTree(uint64(e))
+		}
+		n += 1 + sovTree(uint64(l)) + l
+	}
+	if m.Moved {
+		n += 2
+	}
+	return n
+}
+
+func (m *SizeDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Items != 0 {
+		n += 1 + sovTree(uint64(m.Items))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovTree(uint64(m.Bytes))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *WatchEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTree(uint64(m.Type))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *Span) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.SpanId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.ParentSpanId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.QueuedAt != 0 {
+		n += 1 + sovTree(uint64(m.QueuedAt))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovTree(uint64(m.StartedAt))
+	}
+	if m.EndedAt != 0 {
+		n += 1 + sovTree(uint64(m.EndedAt))
+	}
+	return n
+}
+
+func (m *TraceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTree(uint64(l))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovTree(uint64(m.Deadline))
+	}
+	if m.Credentials != nil {
+		l = m.Credentials.Size()
+		n += 1 + l + sovTree(uint64(l))
+	}
+	return n
+}
+
+func (m *TraceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spans) > 0 {
+		for _, e := range m.Spans {
+			l = e.Size()
+			n += 1 + l + sovTree(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTree(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTree(x uint64) (n int) {
+	return sovTree(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Credentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Credentials{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Item) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Item{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Version) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Version{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionedItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionedItem{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Versions:` + strings.Replace(fmt.Sprintf("%v", this.Versions), "Version", "Version", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Quota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Quota{`,
+		`MaxItems:` + fmt.Sprintf("%v", this.MaxItems) + `,`,
+		`MaxValueBytes:` + fmt.Sprintf("%v", this.MaxValueBytes) + `,`,
+		`MaxTotalBytes:` + fmt.Sprintf("%v", this.MaxTotalBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Snapshot) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Snapshot{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoSuchTreeError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoSuchTreeError{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InvalidTokenError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InvalidTokenError{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoSuchKeyError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoSuchKeyError{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyAlreadyExistsError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyAlreadyExistsError{`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoSuchTxError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoSuchTxError{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxAbortedError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxAbortedError{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NoSuchSnapshotError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NoSuchSnapshotError{`,
+		`SnapshotId:` + fmt.Sprintf("%v", this.SnapshotId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyLockedError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyLockedError{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeadlineExceededError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeadlineExceededError{`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaExceededError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaExceededError{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`Requested:` + fmt.Sprintf("%v", this.Requested) + `,`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RateLimitedError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RateLimitedError{`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`RetryAfter:` + fmt.Sprintf("%v", this.RetryAfter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OverloadedError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OverloadedError{`,
+		`Node:` + fmt.Sprintf("%v", this.Node) + `,`,
+		`Capacity:` + fmt.Sprintf("%v", this.Capacity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShuttingDownError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShuttingDownError{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InvalidRequestError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InvalidRequestError{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTreeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTreeRequest{`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`IdleTimeout:` + fmt.Sprintf("%v", this.IdleTimeout) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`Quota:` + strings.Replace(fmt.Sprintf("%v", this.Quota), "Quota", "Quota", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateTreeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateTreeResponse{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TreeInfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TreeInfoRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TreeInfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TreeInfoResponse{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`IdleTimeout:` + fmt.Sprintf("%v", this.IdleTimeout) + `,`,
+		`Quota:` + strings.Replace(fmt.Sprintf("%v", this.Quota), "Quota", "Quota", 1) + `,`,
+		`Items:` + fmt.Sprintf("%v", this.Items) + `,`,
+		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloneTreeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloneTreeRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`IdleTimeout:` + fmt.Sprintf("%v", this.IdleTimeout) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloneTreeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloneTreeResponse{`,
+		`Source:` + strings.Replace(fmt.Sprintf("%v", this.Source), "Credentials", "Credentials", 1) + `,`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`ItemCount:` + fmt.Sprintf("%v", this.ItemCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetTreeExpiryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetTreeExpiryRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`IdleTimeout:` + fmt.Sprintf("%v", this.IdleTimeout) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetTreeExpiryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetTreeExpiryResponse{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`IdleTimeout:` + fmt.Sprintf("%v", this.IdleTimeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTreeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTreeRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteTreeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteTreeResponse{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InsertRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InsertRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Ttl:` + fmt.Sprintf("%v", this.Ttl) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InsertResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InsertResponse{`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InsertBatchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InsertBatchRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Item", "Item", 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InsertBatchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InsertBatchResponse{`,
+		`Inserted:` + fmt.Sprintf("%v", this.Inserted) + `,`,
+		`Existing:` + strings.Replace(fmt.Sprintf("%v", this.Existing), "Item", "Item", 1) + `,`,
+		`Locked:` + fmt.Sprintf("%v", this.Locked) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteResponse{`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Snapshot:` + strings.Replace(fmt.Sprintf("%v", this.Snapshot), "Snapshot", "Snapshot", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SearchResponse{`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TraverseRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TraverseRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`Snapshot:` + strings.Replace(fmt.Sprintf("%v", this.Snapshot), "Snapshot", "Snapshot", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TraverseResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TraverseResponse{`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RangeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RangeRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Snapshot:` + strings.Replace(fmt.Sprintf("%v", this.Snapshot), "Snapshot", "Snapshot", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RangeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RangeResponse{`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotResponse{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`SnapshotId:` + fmt.Sprintf("%v", this.SnapshotId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseSnapshotRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseSnapshotRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`SnapshotId:` + fmt.Sprintf("%v", this.SnapshotId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseSnapshotResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseSnapshotResponse{`,
+		`SnapshotId:` + fmt.Sprintf("%v", this.SnapshotId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActiveSnapshots) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActiveSnapshots{`,
+		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeExpired) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeExpired{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Now:` + fmt.Sprintf("%v", this.Now) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxOperation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxOperation{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BeginTxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BeginTxRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BeginTxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BeginTxResponse{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageTxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StageTxRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Operation:` + strings.Replace(fmt.Sprintf("%v", this.Operation), "TxOperation", "TxOperation", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageTxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StageTxResponse{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Operation:` + strings.Replace(fmt.Sprintf("%v", this.Operation), "TxOperation", "TxOperation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CommitTxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CommitTxRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`IdempotencyKey:` + fmt.Sprintf("%v", this.IdempotencyKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CommitTxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CommitTxResponse{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Operations:` + strings.Replace(fmt.Sprintf("%v", this.Operations), "TxOperation", "TxOperation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AbortTxRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AbortTxRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AbortTxResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AbortTxResponse{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxPrepare) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxPrepare{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Operation:` + strings.Replace(fmt.Sprintf("%v", this.Operation), "TxOperation", "TxOperation", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxVote) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxVote{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Commit:` + fmt.Sprintf("%v", this.Commit) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxVersionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxVersionRequest{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxVersionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxVersionResponse{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxCommit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxCommit{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxAbort) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxAbort{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxAck{`,
+		`TxId:` + fmt.Sprintf("%v", this.TxId) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportHeader{`,
+		`MaxSize:` + fmt.Sprintf("%v", this.MaxSize) + `,`,
+		`ItemCount:` + fmt.Sprintf("%v", this.ItemCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultiInsert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MultiInsert{`,
+		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Item", "Item", 1) + `,`,
+		`Locks:` + strings.Replace(fmt.Sprintf("%v", this.Locks), "TxPrepare", "TxPrepare", 1) + `,`,
+		`VersionedItems:` + strings.Replace(fmt.Sprintf("%v", this.VersionedItems), "VersionedItem", "VersionedItem", 1) + `,`,
+		`Snapshots:` + fmt.Sprintf("%v", this.Snapshots) + `,`,
+		`Moved:` + fmt.Sprintf("%v", this.Moved) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SizeDelta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SizeDelta{`,
+		`Items:` + fmt.Sprintf("%v", this.Items) + `,`,
+		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchRequest{`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchEvent{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Item:` + strings.Replace(fmt.Sprintf("%v", this.Item), "Item", "Item", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Span) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Span{`,
+		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`SpanId:` + fmt.Sprintf("%v", this.SpanId) + `,`,
+		`ParentSpanId:` + fmt.Sprintf("%v", this.ParentSpanId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`QueuedAt:` + fmt.Sprintf("%v", this.QueuedAt) + `,`,
+		`StartedAt:` + fmt.Sprintf("%v", this.StartedAt) + `,`,
+		`EndedAt:` + fmt.Sprintf("%v", this.EndedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TraceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TraceRequest{`,
+		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`Deadline:` + fmt.Sprintf("%v", this.Deadline) + `,`,
+		`Credentials:` + strings.Replace(fmt.Sprintf("%v", this.Credentials), "Credentials", "Credentials", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TraceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TraceResponse{`,
+		`Spans:` + strings.Replace(fmt.Sprintf("%v", this.Spans), "Span", "Span", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTree(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Credentials) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Credentials: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Credentials: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Version) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Version: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Version: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionedItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionedItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionedItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &Version{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxItems", wireType)
+			}
+			m.MaxItems = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxItems |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueBytes", wireType)
+			}
+			m.MaxValueBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValueBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBytes", wireType)
+			}
+			m.MaxTotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoSuchTreeError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoSuchTreeError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoSuchTreeError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvalidTokenError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidTokenError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidTokenError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoSuchKeyError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoSuchKeyError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoSuchKeyError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAlreadyExistsError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAlreadyExistsError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAlreadyExistsError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoSuchTxError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoSuchTxError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoSuchTxError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxAbortedError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxAbortedError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxAbortedError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoSuchSnapshotError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoSuchSnapshotError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoSuchSnapshotError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyLockedError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyLockedError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyLockedError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeadlineExceededError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadlineExceededError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadlineExceededError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaExceededError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaExceededError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaExceededError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requested", wireType)
+			}
+			m.Requested = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requested |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitedError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitedError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitedError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAfter", wireType)
+			}
+			m.RetryAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverloadedError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverloadedError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverloadedError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShuttingDownError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShuttingDownError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShuttingDownError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvalidRequestError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidRequestError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidRequestError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			m.IdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			m.IdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &Quota{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			m.Items = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Items |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloneTreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloneTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloneTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			m.IdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloneTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloneTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloneTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &Credentials{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemCount", wireType)
+			}
+			m.ItemCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetTreeExpiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetTreeExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetTreeExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			m.IdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetTreeExpiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetTreeExpiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetTreeExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
+			}
+			m.IdleTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTreeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTreeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteTreeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteTreeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsertBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsertBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsertBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inserted", wireType)
+			}
+			m.Inserted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inserted |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Existing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Existing = append(m.Existing, &Item{})
+			if err := m.Existing[len(m.Existing)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Locked = append(m.Locked, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTree
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTree
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Locked) == 0 {
+					m.Locked = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTree
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Locked = append(m.Locked, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &Snapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraverseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraverseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraverseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &Snapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraverseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraverseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraverseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &Snapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveSnapshots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveSnapshots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Versions = append(m.Versions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTree
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTree
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Versions) == 0 {
+					m.Versions = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTree
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Versions = append(m.Versions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Now", wireType)
+			}
+			m.Now = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Now |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TxOperation_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeginTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeginTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeginTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StageTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StageTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StageTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &TxOperation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StageTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StageTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StageTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Operation == nil {
+				m.Operation = &TxOperation{}
+			}
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdempotencyKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdempotencyKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &TxOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbortTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbortTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbortTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Credentials == nil {
+				m.Credentials = &Credentials{}
+			}
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&TraverseResponse{`,
-		`Items:` + strings.Replace(fmt.Sprintf("%v", this.Items), "Item", "Item", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringTree(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *NoSuchTreeError) Unmarshal(dAtA []byte) error {
+func (m *AbortTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,17 +20665,17 @@ func (m *NoSuchTreeError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoSuchTreeError: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbortTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoSuchTreeError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbortTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			m.Id = 0
+			m.TxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -2726,7 +20685,7 @@ func (m *NoSuchTreeError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.TxId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2755,7 +20714,7 @@ func (m *NoSuchTreeError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InvalidTokenError) Unmarshal(dAtA []byte) error {
+func (m *TxPrepare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2778,15 +20737,34 @@ func (m *InvalidTokenError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InvalidTokenError: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxPrepare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InvalidTokenError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxPrepare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2813,13 +20791,45 @@ func (m *InvalidTokenError) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &Credentials{}
+			if m.Operation == nil {
+				m.Operation = &TxOperation{}
 			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTree(dAtA[iNdEx:])
@@ -2844,7 +20854,7 @@ func (m *InvalidTokenError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NoSuchKeyError) Unmarshal(dAtA []byte) error {
+func (m *TxVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2867,13 +20877,32 @@ func (m *NoSuchKeyError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NoSuchKeyError: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoSuchKeyError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
@@ -2892,6 +20921,58 @@ func (m *NoSuchKeyError) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Commit = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTree(dAtA[iNdEx:])
@@ -2916,7 +20997,7 @@ func (m *NoSuchKeyError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAlreadyExistsError) Unmarshal(dAtA []byte) error {
+func (m *TxVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2939,17 +21020,36 @@ func (m *KeyAlreadyExistsError) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAlreadyExistsError: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAlreadyExistsError: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -2959,28 +21059,115 @@ func (m *KeyAlreadyExistsError) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &Item{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTree(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if skippy < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTree
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTree
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTree(dAtA[iNdEx:])
@@ -3005,7 +21192,7 @@ func (m *KeyAlreadyExistsError) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Credentials) Unmarshal(dAtA []byte) error {
+func (m *TxCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3028,17 +21215,17 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Credentials: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Credentials: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			m.Id = 0
+			m.TxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3048,14 +21235,52 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.TxId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3083,7 +21308,7 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3109,7 +21334,7 @@ func (m *Credentials) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *TxAbort) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3132,13 +21357,32 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxAbort: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxAbort: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
@@ -3157,9 +21401,9 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3187,7 +21431,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3213,7 +21457,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTreeRequest) Unmarshal(dAtA []byte) error {
+func (m *TxAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3236,17 +21480,17 @@ func (m *CreateTreeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTreeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 			}
-			m.MaxSize = 0
+			m.TxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3256,7 +21500,26 @@ func (m *CreateTreeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSize |= int64(b&0x7F) << shift
+				m.TxId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3285,7 +21548,7 @@ func (m *CreateTreeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTreeResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,17 +21571,17 @@ func (m *CreateTreeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTreeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
 			}
-			var msglen int
+			m.MaxSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3328,28 +21591,30 @@ func (m *CreateTreeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTree
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTree
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Credentials == nil {
-				m.Credentials = &Credentials{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemCount", wireType)
 			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ItemCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTree(dAtA[iNdEx:])
@@ -3374,7 +21639,7 @@ func (m *CreateTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteTreeRequest) Unmarshal(dAtA []byte) error {
+func (m *MultiInsert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3397,15 +21662,15 @@ func (m *DeleteTreeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTreeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiInsert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiInsert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3432,13 +21697,175 @@ func (m *DeleteTreeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &Credentials{}
+			m.Items = append(m.Items, &Item{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, &TxPrepare{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTree
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionedItems = append(m.VersionedItems, &VersionedItem{})
+			if err := m.VersionedItems[len(m.VersionedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Snapshots = append(m.Snapshots, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTree
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTree
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTree
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Snapshots) == 0 {
+					m.Snapshots = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTree
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Snapshots = append(m.Snapshots, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Moved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTree(dAtA[iNdEx:])
@@ -3463,7 +21890,7 @@ func (m *DeleteTreeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteTreeResponse) Unmarshal(dAtA []byte) error {
+func (m *SizeDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3486,17 +21913,55 @@ func (m *DeleteTreeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTreeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SizeDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SizeDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			m.Items = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Items |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3506,27 +21971,23 @@ func (m *DeleteTreeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &Credentials{}
-			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3552,7 +22013,7 @@ func (m *DeleteTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InsertRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3575,10 +22036,10 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InsertRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3619,9 +22080,9 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3631,86 +22092,29 @@ func (m *InsertRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &Item{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTree(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTree
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthTree
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InsertResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTree
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InsertResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InsertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			var msglen int
+			m.Deadline = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3720,28 +22124,11 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Deadline |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTree
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTree
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Item == nil {
-				m.Item = &Item{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTree(dAtA[iNdEx:])
@@ -3766,7 +22153,7 @@ func (m *InsertResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiInsert) Unmarshal(dAtA []byte) error {
+func (m *WatchEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3789,15 +22176,34 @@ func (m *MultiInsert) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiInsert: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiInsert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= WatchEvent_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3824,8 +22230,10 @@ func (m *MultiInsert) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Item{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Item == nil {
+				m.Item = &Item{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3853,7 +22261,7 @@ func (m *MultiInsert) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *Span) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3876,17 +22284,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Span: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Span: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3896,33 +22304,29 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &Credentials{}
-			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
 			}
-			m.Key = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -3932,69 +22336,29 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTree(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTree
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SpanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentSpanId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -4004,86 +22368,61 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &Item{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParentSpanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTree(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTree
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SearchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTree
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTree
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SearchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -4093,33 +22432,48 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &Credentials{}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
 			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.QueuedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			m.Key = 0
+			m.StartedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -4129,7 +22483,26 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= int64(b&0x7F) << shift
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndedAt", wireType)
+			}
+			m.EndedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4158,7 +22531,7 @@ func (m *SearchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SearchResponse) Unmarshal(dAtA []byte) error {
+func (m *TraceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4181,17 +22554,17 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTree
@@ -4201,82 +22574,76 @@ func (m *SearchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &Item{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTree(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTree
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTree
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TraverseRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTree
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTree
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TraverseRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraverseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
@@ -4336,7 +22703,7 @@ func (m *TraverseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TraverseResponse) Unmarshal(dAtA []byte) error {
+func (m *TraceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4359,15 +22726,15 @@ func (m *TraverseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TraverseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraverseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4394,8 +22761,8 @@ func (m *TraverseResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Item{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Spans = append(m.Spans, &Span{})
+			if err := m.Spans[len(m.Spans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,76 @@
+package remotetls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLS settings of the remote traffic between treeservice and treecli, given by the flags --tls-cert, --tls-key,
+// --tls-ca and --tls-client-auth of both. Each side accepts connections, because the treeservice connects back
+// to treecli to send responses, so both need a certificate. It is presented as client certificate as well.
+type Config struct {
+	CertFile   string
+	KeyFile    string
+	CAFile     string
+	ClientAuth bool
+}
+
+// Returns whether any TLS flag is set. Without them the remote traffic stays plaintext.
+func (c Config) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.CAFile != "" || c.ClientAuth
+}
+
+// Returns the options for remote.Start. The certificates of the peers are verified with the CA of CAFile
+// or the system's CAs if it is empty. With ClientAuth connections are only accepted from peers presenting
+// a certificate signed by the CA of CAFile.
+func (c Config) Options() ([]remote.RemotingOption, error) {
+	if !c.Enabled() {
+		return nil, nil
+	}
+	server, client, err := c.tlsConfigs()
+	if err != nil {
+		return nil, err
+	}
+	return []remote.RemotingOption{
+		remote.WithServerOptions(grpc.Creds(credentials.NewTLS(server))),
+		remote.WithDialOptions(grpc.WithTransportCredentials(credentials.NewTLS(client))),
+	}, nil
+}
+
+// Returns the TLS configurations for accepting and for opening connections.
+func (c Config) tlsConfigs() (*tls.Config, *tls.Config, error) {
+	if c.CertFile == "" || c.KeyFile == "" {
+		return nil, nil, errors.New("TLS needs --tls-cert and --tls-key, as treeservice and treecli both accept connections")
+	}
+	if c.ClientAuth && c.CAFile == "" {
+		return nil, nil, errors.New("--tls-client-auth needs --tls-ca to verify client certificates")
+	}
+	certificate, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load TLS certificate: %v", err)
+	}
+	var pool *x509.CertPool
+	if c.CAFile != "" {
+		pem, err := ioutil.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read TLS CA: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, nil, fmt.Errorf("no certificates found in %s", c.CAFile)
+		}
+	}
+	server := &tls.Config{Certificates: []tls.Certificate{certificate}, ClientCAs: pool}
+	if c.ClientAuth {
+		server.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	client := &tls.Config{Certificates: []tls.Certificate{certificate}, RootCAs: pool}
+	return server, client, nil
+}
@@ -0,0 +1,260 @@
+package remotetls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// CA generated for a test, issuing certificates for treeservice and treecli into dir.
+type testCA struct {
+	dir         string
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	serial      int64
+}
+
+// Generates a CA and writes its certificate to ca.pem in a new temporary directory.
+func newTestCA(t *testing.T) *testCA {
+	dir, err := ioutil.TempDir("", "remotetls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := &testCA{dir: dir}
+	ca.key = generateKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "treeservice test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &ca.key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ca.certificate, err = x509.ParseCertificate(der); err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, ca.file(), "CERTIFICATE", der)
+	return ca
+}
+
+func (ca *testCA) file() string {
+	return filepath.Join(ca.dir, "ca.pem")
+}
+
+func (ca *testCA) remove() {
+	os.RemoveAll(ca.dir)
+}
+
+// Issues a certificate for name valid for localhost, usable for accepting and opening connections like those of
+// treeservice and treecli. Returns a Config with its certificate and key and the CA.
+func (ca *testCA) issue(t *testing.T, name string) Config {
+	key := generateKey(t)
+	ca.serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial + 1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := Config{
+		CertFile: filepath.Join(ca.dir, name+".pem"),
+		KeyFile:  filepath.Join(ca.dir, name+"-key.pem"),
+		CAFile:   ca.file(),
+	}
+	writePEM(t, config.CertFile, "CERTIFICATE", der)
+	writePEM(t, config.KeyFile, "EC PRIVATE KEY", keyDER)
+	return config
+}
+
+func generateKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Returns the TLS configurations of the config, failing the test on errors.
+func tlsConfigsOf(t *testing.T, config Config) (*tls.Config, *tls.Config) {
+	server, client, err := config.tlsConfigs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+// Serves the gRPC health service over TLS like remote serves the actor messages. Returns its address and
+// a function stopping it.
+func serve(t *testing.T, config *tls.Config) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return listener.Addr().String(), server.Stop
+}
+
+// Makes a round trip to the server over a connection with the TLS configuration.
+func roundTrip(address string, config *tls.Config) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestRoundTrip(t *testing.T) {
+	ca := newTestCA(t)
+	defer ca.remove()
+	server, _ := tlsConfigsOf(t, ca.issue(t, "treeservice"))
+	_, client := tlsConfigsOf(t, ca.issue(t, "treecli"))
+	address, stop := serve(t, server)
+	defer stop()
+
+	if err := roundTrip(address, client); err != nil {
+		t.Fatalf("round trip over TLS failed: %v", err)
+	}
+}
+
+func TestUnknownCAIsRejected(t *testing.T) {
+	ca, other := newTestCA(t), newTestCA(t)
+	defer ca.remove()
+	defer other.remove()
+	server, _ := tlsConfigsOf(t, ca.issue(t, "treeservice"))
+	_, client := tlsConfigsOf(t, other.issue(t, "treecli"))
+	address, stop := serve(t, server)
+	defer stop()
+
+	if err := roundTrip(address, client); err == nil {
+		t.Fatal("treecli accepted a treeservice whose certificate is signed by another CA")
+	}
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	defer ca.remove()
+	serverConfig := ca.issue(t, "treeservice")
+	serverConfig.ClientAuth = true
+	server, _ := tlsConfigsOf(t, serverConfig)
+	_, client := tlsConfigsOf(t, ca.issue(t, "treecli"))
+	address, stop := serve(t, server)
+	defer stop()
+
+	if err := roundTrip(address, client); err != nil {
+		t.Fatalf("round trip with client certificate failed: %v", err)
+	}
+}
+
+func TestClientWithoutCertificateIsRejected(t *testing.T) {
+	ca := newTestCA(t)
+	defer ca.remove()
+	serverConfig := ca.issue(t, "treeservice")
+	serverConfig.ClientAuth = true
+	server, client := tlsConfigsOf(t, serverConfig)
+	address, stop := serve(t, server)
+	defer stop()
+
+	client.Certificates = nil
+	if err := roundTrip(address, client); err == nil {
+		t.Fatal("treeservice with --tls-client-auth accepted a client without certificate")
+	}
+}
+
+func TestClientWithCertificateOfUnknownCAIsRejected(t *testing.T) {
+	ca, other := newTestCA(t), newTestCA(t)
+	defer ca.remove()
+	defer other.remove()
+	serverConfig := ca.issue(t, "treeservice")
+	serverConfig.ClientAuth = true
+	server, _ := tlsConfigsOf(t, serverConfig)
+	_, client := tlsConfigsOf(t, other.issue(t, "treecli"))
+	// The client trusts the treeservice, but the treeservice doesn't trust the client's CA
+	client.RootCAs = server.ClientCAs
+	address, stop := serve(t, server)
+	defer stop()
+
+	if err := roundTrip(address, client); err == nil {
+		t.Fatal("treeservice with --tls-client-auth accepted a client certificate signed by another CA")
+	}
+}
+
+func TestOptions(t *testing.T) {
+	ca := newTestCA(t)
+	defer ca.remove()
+	valid := ca.issue(t, "treeservice")
+	tests := []struct {
+		name    string
+		config  Config
+		options int
+		fails   bool
+	}{
+		{"plaintext", Config{}, 0, false},
+		{"TLS", valid, 2, false},
+		{"TLS with system CAs", Config{CertFile: valid.CertFile, KeyFile: valid.KeyFile}, 2, false},
+		{"client auth", Config{CertFile: valid.CertFile, KeyFile: valid.KeyFile, CAFile: valid.CAFile, ClientAuth: true},
+			2, false},
+		{"client auth without CA", Config{CertFile: valid.CertFile, KeyFile: valid.KeyFile, ClientAuth: true}, 0, true},
+		{"CA without certificate", Config{CAFile: valid.CAFile}, 0, true},
+		{"missing key", Config{CertFile: valid.CertFile, KeyFile: filepath.Join(ca.dir, "missing.pem")}, 0, true},
+		{"CA without certificates", Config{CertFile: valid.CertFile, KeyFile: valid.KeyFile, CAFile: valid.KeyFile},
+			0, true},
+	}
+	for _, test := range tests {
+		options, err := test.config.Options()
+		if (err != nil) != test.fails {
+			t.Errorf("%s: expected failure %t, got %v", test.name, test.fails, err)
+		}
+		if len(options) != test.options {
+			t.Errorf("%s: expected %d options, got %d", test.name, test.options, len(options))
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+)
+
+// Number of traces whose spans are kept for TraceRequests.
+const keptTraces = 1000
+
+// Spans are exported in batches of this size or after this interval.
+const (
+	exportBatchSize = 512
+	exportInterval  = time.Second
+)
+
+// Writes a batch of spans somewhere.
+type exporter func(batch []*messages.Span) error
+
+// Spans recorded for a trace and the id of the tree it belongs to, 0 until the treeservice names it.
+type trace struct {
+	spans []*messages.Span
+	tree  int64
+}
+
+var (
+	mutex     sync.Mutex
+	traces    = make(map[string]*trace)
+	order     []string
+	exporters []exporter
+	exports   chan *messages.Span
+	flushes   chan chan struct{}
+)
+
+// Returns the kept trace with the given id, replacing the oldest one if it is new. The mutex must be held.
+func traceOf(traceID string) *trace {
+	if t, exists := traces[traceID]; exists {
+		return t
+	}
+	if len(order) == keptTraces {
+		delete(traces, order[0])
+		order = order[1:]
+	}
+	order = append(order, traceID)
+	traces[traceID] = &trace{}
+	return traces[traceID]
+}
+
+func record(span *messages.Span) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	t := traceOf(span.TraceId)
+	t.spans = append(t.spans, span)
+	if exports != nil {
+		select {
+		case exports <- span:
+		default:
+			logrus.Warnf("Span export queue full, dropping span %s of trace %s", span.SpanId, span.TraceId)
+		}
+	}
+}
+
+// Names the tree the traced message with the header belongs to, so only requests with its credentials get the
+// spans. The first tree named keeps the trace.
+func Own(header actor.ReadonlyMessageHeader, tree int64) {
+	traceID, _, ok := extract(header)
+	if !ok {
+		return
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	if t := traceOf(traceID); t.tree == 0 {
+		t.tree = tree
+	}
+}
+
+// Returns the spans recorded for the trace if it belongs to the tree.
+func Spans(traceID string, tree int64) []*messages.Span {
+	mutex.Lock()
+	defer mutex.Unlock()
+	t, exists := traces[traceID]
+	if !exists || t.tree != tree {
+		return nil
+	}
+	return append([]*messages.Span(nil), t.spans...)
+}
+
+func addExporter(export exporter) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	exporters = append(exporters, export)
+	if exports == nil {
+		exports = make(chan *messages.Span, exportBatchSize*4)
+		flushes = make(chan chan struct{})
+		go exportBatches(exports, flushes)
+	}
+}
+
+// Exports the spans recorded so far without waiting for the export interval, e.g. before the process exits.
+// Returns an error if that takes longer than timeout.
+func Flush(timeout time.Duration) error {
+	mutex.Lock()
+	requests := flushes
+	mutex.Unlock()
+	if requests == nil {
+		return nil
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	done := make(chan struct{})
+	select {
+	case requests <- done:
+	case <-timer.C:
+		return fmt.Errorf("span export didn't start within %s", timeout)
+	}
+	select {
+	case <-done:
+		return nil
+	case <-timer.C:
+		return fmt.Errorf("span export didn't finish within %s", timeout)
+	}
+}
+
+func exportBatches(spans <-chan *messages.Span, flushes <-chan chan struct{}) {
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+	batch := make([]*messages.Span, 0, exportBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		mutex.Lock()
+		current := exporters
+		mutex.Unlock()
+		for _, export := range current {
+			if err := export(batch); err != nil {
+				logrus.Errorf("Failed to export %d spans: %v", len(batch), err)
+			}
+		}
+		batch = make([]*messages.Span, 0, exportBatchSize)
+	}
+	for {
+		select {
+		case span := <-spans:
+			batch = append(batch, span)
+			if len(batch) == exportBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case done := <-flushes:
+			for pending := len(spans); pending > 0; pending-- {
+				batch = append(batch, <-spans)
+				if len(batch) == exportBatchSize {
+					flush()
+				}
+			}
+			flush()
+			close(done)
+		}
+	}
+}
+
+// Appends all spans to the file, one OTLP/JSON export request per line as the file exporter
+// of the OpenTelemetry collector writes them.
+func ExportToFile(path string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	addExporter(func(batch []*messages.Span) error {
+		data, err := json.Marshal(otlpRequest(batch))
+		if err != nil {
+			return err
+		}
+		_, err = file.Write(append(data, '\n'))
+		return err
+	})
+	return nil
+}
+
+// Sends all spans to an OTLP/HTTP collector, e.g. http://localhost:4318.
+func ExportToCollector(endpoint string) {
+	url := strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+	client := &http.Client{Timeout: 10 * time.Second}
+	addExporter(func(batch []*messages.Span) error {
+		data, err := json.Marshal(otlpRequest(batch))
+		if err != nil {
+			return err
+		}
+		res, err := client.Post(url, "application/json", bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+		if res.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("collector at %s answered %s", url, res.Status)
+		}
+		return nil
+	})
+}
+
+// JSON encoding of an OTLP ExportTraceServiceRequest.
+type otlpAttribute struct {
+	Key   string            `json:"key"`
+	Value map[string]string `json:"value"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes"`
+}
+
+func otlpRequest(batch []*messages.Span) interface{} {
+	const spanKindInternal = 1
+	spans := make([]otlpSpan, 0, len(batch))
+	for _, span := range batch {
+		wait := strconv.FormatInt(span.StartedAt-span.QueuedAt, 10)
+		spans = append(spans, otlpSpan{
+			TraceID:           span.TraceId,
+			SpanID:            span.SpanId,
+			ParentSpanID:      span.ParentSpanId,
+			Name:              span.Name,
+			Kind:              spanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.StartedAt, 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.EndedAt, 10),
+			Attributes: []otlpAttribute{
+				{Key: "actor.pid", Value: map[string]string{"stringValue": span.Actor}},
+				{Key: "mailbox.wait_ns", Value: map[string]string{"intValue": wait}},
+			},
+		})
+	}
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{
+				"attributes": []otlpAttribute{{Key: "service.name", Value: map[string]string{"stringValue": "treeservice"}}},
+			},
+			"scopeSpans": []interface{}{map[string]interface{}{
+				"scope": map[string]string{"name": "github.com/ob-vss-ss19/blatt-3-forever_alone/tracing"},
+				"spans": spans,
+			}},
+		}},
+	}
+}
@@ -0,0 +1,155 @@
+package tracing
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Message headers carrying the trace context. TraceParentHeader has the format of W3C trace context:
+// 00-<trace id>-<span id of the sender>-01. SentAtHeader holds the unix time in nanoseconds the message was sent at.
+const (
+	TraceParentHeader = "traceparent"
+	SentAtHeader      = "trace-sent-at"
+)
+
+// Span id of the sender of messages starting a trace.
+const noParent = "0000000000000000"
+
+func randomHex(bytes int) string {
+	id := make([]byte, bytes)
+	_, _ = rand.Read(id)
+	return fmt.Sprintf("%x", id)
+}
+
+func NewTraceID() string {
+	return randomHex(16)
+}
+
+func NewSpanID() string {
+	return randomHex(8)
+}
+
+// Sets the headers passing the trace context on to the receiver of the envelope.
+// parentSpanID may be empty if the receiver starts the trace.
+func Inject(envelope *actor.MessageEnvelope, traceID, parentSpanID string) {
+	if parentSpanID == "" {
+		parentSpanID = noParent
+	}
+	envelope.SetHeader(TraceParentHeader, fmt.Sprintf("00-%s-%s-01", traceID, parentSpanID))
+	envelope.SetHeader(SentAtHeader, strconv.FormatInt(time.Now().UnixNano(), 10))
+}
+
+// Returns trace id and span id of the sender from the headers. ok is false if the message isn't traced.
+func extract(header actor.ReadonlyMessageHeader) (traceID, parentSpanID string, ok bool) {
+	if header == nil {
+		return "", "", false
+	}
+	parts := strings.Split(header.Get(TraceParentHeader), "-")
+	if len(parts) != 4 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return "", "", false
+	}
+	if parts[2] == noParent {
+		return parts[1], "", true
+	}
+	return parts[1], parts[2], true
+}
+
+// Actors naming their kind in spans, e.g. leaf or internal for nodes.
+type kinded interface {
+	Kind() string
+}
+
+func spanName(receiver actor.Actor, message interface{}) string {
+	name := messages.TypeOf(message)
+	if k, ok := receiver.(kinded); ok {
+		return k.Kind() + " " + name
+	}
+	return name
+}
+
+// Records a span for every traced message the actor receives. While the actor handles the message
+// the header names the new span, so SenderMiddleware passes it on as parent.
+func ReceiverMiddleware(next actor.ReceiverFunc) actor.ReceiverFunc {
+	return func(c actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+		traceID, parentSpanID, ok := extract(envelope.Header)
+		if !ok {
+			next(c, envelope)
+			return
+		}
+		span := &messages.Span{
+			TraceId:      traceID,
+			SpanId:       NewSpanID(),
+			ParentSpanId: parentSpanID,
+			Name:         spanName(c.Actor(), envelope.Message),
+			Actor:        c.Self().Id,
+			StartedAt:    time.Now().UnixNano(),
+		}
+		span.QueuedAt, _ = strconv.ParseInt(envelope.GetHeader(SentAtHeader), 10, 64)
+		if span.QueuedAt == 0 {
+			span.QueuedAt = span.StartedAt
+		}
+		envelope.SetHeader(TraceParentHeader, fmt.Sprintf("00-%s-%s-01", traceID, span.SpanId))
+		next(c, envelope)
+		span.EndedAt = time.Now().UnixNano()
+		record(span)
+	}
+}
+
+// Passes the trace context of the message the actor is handling on to all messages it sends.
+// The envelope is copied because forwarded envelopes are shared with the actor's own mailbox.
+func SenderMiddleware(next actor.SenderFunc) actor.SenderFunc {
+	return func(c actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		traceID, spanID, ok := extract(c.MessageHeader())
+		if !ok {
+			next(c, target, envelope)
+			return
+		}
+		traced := &actor.MessageEnvelope{Message: envelope.Message, Sender: envelope.Sender}
+		if envelope.Header != nil {
+			for key, value := range envelope.Header.ToMap() {
+				traced.SetHeader(key, value)
+			}
+		}
+		Inject(traced, traceID, spanID)
+		next(c, target, traced)
+	}
+}
+
+// Requests of tree.proto, which all carry a request id.
+type request interface {
+	GetRequestId() string
+}
+
+// Starts a new trace for the given fraction of the requests which aren't traced yet.
+func Sampler(ratio float64) actor.ReceiverMiddleware {
+	return func(next actor.ReceiverFunc) actor.ReceiverFunc {
+		return func(c actor.ReceiverContext, envelope *actor.MessageEnvelope) {
+			_, isRequest := envelope.Message.(request)
+			if _, _, traced := extract(envelope.Header); isRequest && !traced && sampled(ratio) {
+				Inject(envelope, NewTraceID(), "")
+			}
+			next(c, envelope)
+		}
+	}
+}
+
+func sampled(ratio float64) bool {
+	if ratio <= 0 {
+		return false
+	}
+	const precision = 1000000
+	n, err := rand.Int(rand.Reader, big.NewInt(precision))
+	return err == nil && float64(n.Int64()) < ratio*precision
+}
+
+// Adds the middlewares recording and passing on traces to the props.
+func Traced(props *actor.Props) *actor.Props {
+	return props.WithReceiverMiddleware(ReceiverMiddleware).WithSenderMiddleware(SenderMiddleware)
+}
@@ -0,0 +1,24 @@
+package tree
+
+import (
+	"time"
+
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Time internal nodes wait for their children if the request has no deadline.
+const childTimeout = 5 * time.Second
+
+// Returns the time left until the deadline of the message or childTimeout if it has none.
+func timeLeft(message interface{}) time.Duration {
+	return messages.TimeLeft(message, childTimeout)
+}
+
+// Response to requests past their deadline.
+func deadlineExceeded(message interface{}) *messages.DeadlineExceededError {
+	response := &messages.DeadlineExceededError{}
+	if msg, ok := message.(messages.Deadlined); ok {
+		response.Deadline = msg.GetDeadline()
+	}
+	return response
+}
@@ -0,0 +1,130 @@
+package tree
+
+import (
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Length of the windows leafs count their requests in.
+const loadWindow = time.Second
+
+// Requests per second above which a leaf splits although it holds no more than maxSize items, as bits of a float64.
+var hotLeafRate uint64
+
+// Lets leafs split when they receive more than rate requests per second, even if they hold no more than maxSize
+// items. The items are divided so both new leafs get about half of the requests. A rate of 0 turns this off.
+func SplitHotLeafs(rate float64) {
+	atomic.StoreUint64(&hotLeafRate, math.Float64bits(rate))
+}
+
+func hotLeafThreshold() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&hotLeafRate))
+}
+
+// Requests a leaf received in the current window, in total and per key. Traverses and ranges only count in total.
+type leafLoad struct {
+	since    time.Time
+	requests int
+	hits     map[int]int
+	// Labels of the leaf in tree_hot_leaf_requests_per_second, nil while it isn't shown
+	published prometheus.Labels
+}
+
+func (load *leafLoad) count(message interface{}, now time.Time) {
+	if load.since.IsZero() {
+		load.since = now
+	}
+	if !clientRequest(message) {
+		return
+	}
+	if load.hits == nil {
+		load.hits = make(map[int]int)
+	}
+	load.requests++
+	switch msg := message.(type) {
+	case *messages.InsertRequest:
+		load.hits[int(msg.Item.Key)]++
+	case *messages.SearchRequest:
+		load.hits[int(msg.Key)]++
+	case *messages.DeleteRequest:
+		load.hits[int(msg.Key)]++
+	case *messages.InsertBatchRequest:
+		for _, item := range msg.Items {
+			load.hits[int(item.Key)]++
+		}
+	}
+}
+
+// Returns the rate of requests in the window and starts a new one if the current window is over.
+// The hits per key are left for the caller to split by.
+func (load *leafLoad) endWindow(now time.Time) (float64, bool) {
+	elapsed := now.Sub(load.since)
+	if load.since.IsZero() || elapsed < loadWindow {
+		return 0, false
+	}
+	rate := float64(load.requests) / elapsed.Seconds()
+	load.since, load.requests = now, 0
+	return rate, true
+}
+
+// Shows the rate of the leaf with its key range if it reaches half of the threshold, otherwise removes it.
+func (load *leafLoad) publish(node string, content versionedContent, rate, threshold float64) {
+	if rate < threshold/2 || len(content) == 0 {
+		load.unpublish()
+		return
+	}
+	low, high := math.MaxInt64, math.MinInt64
+	for key := range content {
+		if key < low {
+			low = key
+		}
+		if key > high {
+			high = key
+		}
+	}
+	labels := prometheus.Labels{"leaf": node, "keys": fmt.Sprintf("%d..%d", low, high)}
+	if load.published != nil && load.published["keys"] != labels["keys"] {
+		hotLeafRequests.Delete(load.published)
+	}
+	hotLeafRequests.With(labels).Set(rate)
+	load.published = labels
+}
+
+func (load *leafLoad) unpublish() {
+	if load.published != nil {
+		hotLeafRequests.Delete(load.published)
+		load.published = nil
+	}
+}
+
+// Counts the requests of the leaf and splits it if their rate in the last window exceeded the threshold
+// of SplitHotLeafs. A leaf holding a single live key can't split, it stays in tree_hot_leaf_requests_per_second.
+func (state *nodeActor) trackLoad(context actor.Context) {
+	threshold := hotLeafThreshold()
+	if threshold == 0 || state.content == nil {
+		return
+	}
+	now := time.Now()
+	if rate, ended := state.load.endWindow(now); ended {
+		state.load.publish(context.Self().Id, state.content, rate, threshold)
+		if rate > threshold && state.content.size() >= 2 {
+			loggerOf(context).Infof("Leaf %s receives %.0f requests per second - splitting up", context.Self().Id, rate)
+			hits, keys := state.load.hits, state.content.size()
+			itemsLeft, maxLeftSideKey, itemsRight := state.content.splitWeighted(func(key int) int {
+				// A request weighs as much as all keys, which only count so leafs without hits split in halves
+				return 1 + hits[key]*keys
+			})
+			state.split(context, itemsLeft, maxLeftSideKey, itemsRight)
+			loadSplits.Inc()
+			return
+		}
+		state.load.hits = nil
+	}
+	state.load.count(context.Message(), now)
+}
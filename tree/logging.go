@@ -0,0 +1,16 @@
+package tree
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+)
+
+// Returns a logger for the current message of the actor with the fields node and, if known, requestId.
+func loggerOf(context actor.Context) *logrus.Entry {
+	fields := logrus.Fields{"node": context.Self().Id}
+	if id := messages.RequestIDOf(context.Message()); id != "" {
+		fields["requestId"] = id
+	}
+	return logrus.WithFields(fields)
+}
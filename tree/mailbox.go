@@ -0,0 +1,96 @@
+package tree
+
+import (
+	"sync/atomic"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+)
+
+// Number of messages a node actor's mailbox holds before it rejects requests, 0 for no limit.
+var mailboxCapacity int32
+
+// Limits the mailboxes of all node actors spawned afterwards to capacity messages. Requests arriving at a full
+// mailbox are answered with an OverloadedError instead of queueing up, so a hot leaf can't pile up an unbounded
+// backlog. A capacity of 0 lifts the limit.
+func LimitMailboxes(capacity int) {
+	atomic.StoreInt32(&mailboxCapacity, int32(capacity))
+}
+
+// Mailbox of a node actor rejecting requests while it holds capacity messages. Messages the nodes and the
+// treeservice send each other, like split contents, size reports and transaction votes, are always accepted,
+// losing them would corrupt the tree. Implements mailbox.Mailbox and counts its messages as mailbox.Statistics.
+type boundedMailbox struct {
+	mailbox.Mailbox
+	capacity int32
+	depth    int32
+	self     *actor.PID
+}
+
+// Returns a mailbox producer for node actors, bounded if LimitMailboxes set a capacity.
+func nodeMailbox() mailbox.Producer {
+	capacity := atomic.LoadInt32(&mailboxCapacity)
+	if capacity == 0 {
+		return MeasuredMailbox("node")
+	}
+	return func() mailbox.Mailbox {
+		m := &boundedMailbox{capacity: capacity}
+		m.Mailbox = mailbox.Unbounded(
+			&mailboxStatistics{waiting: mailboxMessages.WithLabelValues("node")},
+			m,
+		)()
+		return m
+	}
+}
+
+func (m *boundedMailbox) RegisterHandlers(invoker mailbox.MessageInvoker, dispatcher mailbox.Dispatcher) {
+	if context, ok := invoker.(actor.Context); ok {
+		m.self = context.Self()
+	}
+	m.Mailbox.RegisterHandlers(invoker, dispatcher)
+}
+
+func (m *boundedMailbox) PostUserMessage(message interface{}) {
+	_, request, sender := actor.UnwrapEnvelope(message)
+	if !clientRequest(request) {
+		m.Mailbox.PostUserMessage(message)
+		return
+	}
+	depth := atomic.LoadInt32(&m.depth)
+	mailboxDepth.Observe(float64(depth))
+	if depth < m.capacity {
+		m.Mailbox.PostUserMessage(message)
+		return
+	}
+	overloadedRequests.WithLabelValues(messages.TypeOf(request)).Inc()
+	overloaded := &messages.OverloadedError{Node: m.self.GetId(), Capacity: int64(m.capacity)}
+	logrus.WithField("node", overloaded.Node).Debugf("Node rejects %T, its mailbox holds %d messages", request, depth)
+	if sender != nil {
+		actor.EmptyRootContext.Send(sender, overloaded)
+	}
+}
+
+func (m *boundedMailbox) MailboxStarted() {}
+
+func (m *boundedMailbox) MessagePosted(message interface{}) {
+	atomic.AddInt32(&m.depth, 1)
+}
+
+func (m *boundedMailbox) MessageReceived(message interface{}) {
+	atomic.AddInt32(&m.depth, -1)
+}
+
+func (m *boundedMailbox) MailboxEmpty() {}
+
+// Returns whether the message is a request of a client, which may be rejected and counts as load. Internal nodes
+// pass these on to their children, so an overloaded child's OverloadedError reaches the client as well.
+func clientRequest(message interface{}) bool {
+	switch message.(type) {
+	case *messages.InsertRequest, *messages.InsertBatchRequest, *messages.SearchRequest, *messages.DeleteRequest,
+		*messages.TraverseRequest, *messages.RangeRequest:
+		return true
+	}
+	return false
+}
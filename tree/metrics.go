@@ -0,0 +1,88 @@
+package tree
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	nodeActors = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tree_node_actors",
+		Help: "Number of running node actors by kind (leaf or internal).",
+	}, []string{"kind"})
+	leafSplits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "tree_leaf_splits_total",
+		Help: "Number of leafs split up into two leafs.",
+	})
+	mailboxMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "actor_mailbox_messages",
+		Help: "Number of messages waiting in the mailboxes of all actors of a kind.",
+	}, []string{"actor"})
+	mailboxDepth = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "tree_node_mailbox_depth",
+		Help:    "Number of messages in the mailbox of a node actor when a request arrives, if its mailbox is bounded.",
+		Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+	})
+	overloadedRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "tree_node_overloaded_total",
+		Help: "Number of requests rejected with OverloadedError by type of the request.",
+	}, []string{"type"})
+	hotLeafRequests = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tree_hot_leaf_requests_per_second",
+		Help: "Requests per second of the leafs receiving at least half of the rate which splits them, by key range.",
+	}, []string{"leaf", "keys"})
+	loadSplits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "tree_leaf_load_splits_total",
+		Help: "Number of leafs split up because of their request rate rather than their size.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		nodeActors,
+		leafSplits,
+		mailboxMessages,
+		mailboxDepth,
+		overloadedRequests,
+		hotLeafRequests,
+		loadSplits,
+	)
+}
+
+// Counts the messages in the mailboxes of the actors of one kind. Implements mailbox.Statistics.
+type mailboxStatistics struct {
+	waiting prometheus.Gauge
+}
+
+func (stats *mailboxStatistics) MailboxStarted() {}
+
+func (stats *mailboxStatistics) MessagePosted(message interface{}) {
+	stats.waiting.Inc()
+}
+
+func (stats *mailboxStatistics) MessageReceived(message interface{}) {
+	stats.waiting.Dec()
+}
+
+func (stats *mailboxStatistics) MailboxEmpty() {}
+
+// Returns an unbounded mailbox whose waiting messages are counted in actor_mailbox_messages.
+func MeasuredMailbox(kind string) mailbox.Producer {
+	return mailbox.Unbounded(&mailboxStatistics{waiting: mailboxMessages.WithLabelValues(kind)})
+}
+
+// Returns the props for spawning node actors. Their mailboxes are measured and bounded as set by LimitMailboxes,
+// the messages they handle traced.
+func NodeProps() *actor.Props {
+	return tracing.Traced(actor.PropsFromProducer(NodeActorProducer).WithMailbox(nodeMailbox()))
+}
+
+// Returns leaf or internal. Labels the metrics of the node and prefixes the names of its spans.
+func (state *nodeActor) Kind() string {
+	if state.left != nil {
+		return "internal"
+	}
+	return "leaf"
+}
@@ -1,9 +1,9 @@
 package tree
 
 import (
-	"log"
+	"fmt"
+	"math"
 	"sort"
-	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
@@ -12,102 +12,314 @@ import (
 // Actor for nodes. Implements actor.Actor.
 type nodeActor struct {
 	left, right             *actor.PID
-	content                 map[int]string
+	content                 versionedContent
+	locks                   map[int]*messages.TxPrepare
+	snapshots               []int64
 	maxSize, maxLeftSideKey int
 	behaviour               actor.Behavior
+	// Items and bytes in the subtree and the part of it the parent knows about
+	size, reported messages.SizeDelta
+	load           leafLoad
 }
 
 // Receives messages.
 func (state *nodeActor) Receive(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Started:
+		nodeActors.WithLabelValues(state.Kind()).Inc()
+	case *actor.Stopped:
+		nodeActors.WithLabelValues(state.Kind()).Dec()
+		state.load.unpublish()
+	}
+	if messages.PastDeadline(context.Message()) {
+		loggerOf(context).Debugf("Node %s drops %T past its deadline", context.Self().Id, context.Message())
+		context.Respond(deadlineExceeded(context.Message()))
+		return
+	}
 	state.behaviour.Receive(context)
+	if state.left == nil {
+		state.trackLoad(context)
+	}
+	state.reportSize(context)
+}
+
+// Sends the parent the change of the size of the subtree since the last report. Leafs count their content,
+// internal nodes add up the changes their children report. The root reports to the treeservice.
+func (state *nodeActor) reportSize(context actor.Context) {
+	switch context.Message().(type) {
+	case *actor.Stopping, *actor.Stopped:
+		return
+	}
+	if state.left == nil {
+		state.size = state.content.usage()
+	}
+	if msg, ok := context.Message().(*messages.MultiInsert); ok && msg.Moved {
+		// The parent counted the items before the split
+		state.reported = state.size
+	}
+	if state.size == state.reported {
+		return
+	}
+	// The id of the request, or of the delta of a child, lets the treeservice release the room it reserved
+	context.Request(context.Parent(), &messages.SizeDelta{
+		Items:     state.size.Items - state.reported.Items,
+		Bytes:     state.size.Bytes - state.reported.Bytes,
+		RequestId: messages.RequestIDOf(context.Message()),
+	})
+	state.reported = state.size
 }
 
 // Behaviour for leafs
 func (state *nodeActor) leaf(context actor.Context) {
 	name := context.Self().Id
+	logger := loggerOf(context)
 	switch msg := context.Message().(type) {
 	case *messages.CreateTreeRequest:
 		// Init leaf
 		state.maxSize = int(msg.MaxSize)
-		state.content = make(map[int]string)
-		log.Printf("Leaf %s created with maxSize %d", name, state.maxSize)
+		state.content = make(versionedContent)
+		state.locks = make(map[int]*messages.TxPrepare)
+		logger.Debugf("Leaf %s created with maxSize %d", name, state.maxSize)
 	case *messages.InsertRequest:
-		log.Printf("Leaf %s receives (%d, %s)", name, msg.Item.Key, msg.Item.Value)
-		if value, exists := state.content[int(msg.Item.Key)]; exists {
-			log.Printf("Leaf %s already contains pair with same key: (%d, %s)", name, msg.Item.Key, value)
+		logger.Debugf("Leaf %s receives (%d, %s)", name, msg.Item.Key, msg.Item.Value)
+		if lock, locked := state.locks[int(msg.Item.Key)]; locked {
+			logger.Debugf("Leaf %s has key %d locked by transaction %d", name, msg.Item.Key, lock.TxId)
+			context.Respond(&messages.KeyLockedError{Key: msg.Item.Key, TxId: lock.TxId})
+		} else if value, exists := state.content.get(int(msg.Item.Key), nil); exists {
+			logger.Debugf("Leaf %s already contains pair with same key: (%d, %s)", name, msg.Item.Key, value)
 			context.Respond(&messages.KeyAlreadyExistsError{Item: &messages.Item{Key: msg.Item.Key, Value: value}})
 		} else {
-			state.content[int(msg.Item.Key)] = msg.Item.Value
-			log.Printf("Leaf %s saved (%d, %s)", name, msg.Item.Key, msg.Item.Value)
+			state.content.put(int(msg.Item.Key), msg.Item.Value, msg.Version, msg.ExpiresAt)
+			state.content.prune(int(msg.Item.Key), state.snapshots)
+			logger.Debugf("Leaf %s saved (%d, %s) in version %d", name, msg.Item.Key, msg.Item.Value, msg.Version)
 			context.Respond(&messages.InsertResponse{Item: msg.Item})
 		}
-		if len(state.content) > state.maxSize {
-			log.Printf("Leaf %s too big - splitting up", name)
-			itemsLeft, maxLeftSideKey, itemsRight := split(state.content)
-			state.left = createLeaf(context, int64(state.maxSize), itemsLeft)
-			state.right = createLeaf(context, int64(state.maxSize), itemsRight)
-			state.maxLeftSideKey = maxLeftSideKey
-			for key := range state.content {
-				delete(state.content, key)
+		state.splitIfTooBig(context)
+	case *messages.InsertBatchRequest:
+		response := &messages.InsertBatchResponse{}
+		for _, item := range msg.Items {
+			if _, locked := state.locks[int(item.Key)]; locked {
+				response.Locked = append(response.Locked, item.Key)
+			} else if value, exists := state.content.get(int(item.Key), nil); exists {
+				response.Existing = append(response.Existing, &messages.Item{Key: item.Key, Value: value})
+			} else {
+				state.content.put(int(item.Key), item.Value, msg.Version, item.ExpiresAt)
+				state.content.prune(int(item.Key), state.snapshots)
+				response.Inserted++
 			}
-			state.behaviour.Become(state.internalNode)
-			log.Printf("Leaf %s became internalNode with maxLeftSideKey = %d", name, state.maxLeftSideKey)
 		}
+		logger.Debugf("Leaf %s saved %d of %d items of batch in version %d",
+			name,
+			response.Inserted,
+			len(msg.Items),
+			msg.Version,
+		)
+		context.Respond(response)
+		state.splitIfTooBig(context)
 	case *messages.MultiInsert:
 		// This message type is used when a new leaf must be filled after splitting an internal node up
+		// or when a new tree is bulk loaded. Too many items are passed on by splitting up again.
+		state.snapshots = msg.Snapshots
 		for _, item := range msg.Items {
-			state.content[int(item.Key)] = item.Value
-			log.Printf("Leaf %s saved (%d, %s)", name, item.Key, item.Value)
+			state.content.put(int(item.Key), item.Value, 0, item.ExpiresAt)
+			logger.Debugf("Leaf %s saved (%d, %s)", name, item.Key, item.Value)
+		}
+		for _, item := range msg.VersionedItems {
+			state.content[int(item.Key)] = item.Versions
+			logger.Debugf("Leaf %s saved %d versions of key %d", name, len(item.Versions), item.Key)
+		}
+		for _, lock := range msg.Locks {
+			state.locks[int(lock.Operation.Item.Key)] = lock
+			logger.Debugf("Leaf %s took over lock on key %d for transaction %d", name, lock.Operation.Item.Key, lock.TxId)
 		}
+		state.splitIfTooBig(context)
 	case *messages.SearchRequest:
-		if value, exists := state.content[int(msg.Key)]; exists {
-			log.Printf("Leaf %s contains searched key %d: (%d, %s)", name, msg.Key, msg.Key, value)
+		if value, exists := state.content.get(int(msg.Key), msg.Snapshot); exists {
+			logger.Debugf("Leaf %s contains searched key %d: (%d, %s)", name, msg.Key, msg.Key, value)
 			context.Respond(&messages.SearchResponse{Item: &messages.Item{Key: msg.Key, Value: value}})
 		} else {
-			log.Printf("Leaf %s does not contain searched key %d -> There is no key %d in this tree", name, msg.Key, msg.Key)
+			logger.Debugf("Leaf %s does not contain searched key %d -> There is no key %d in this tree", name, msg.Key, msg.Key)
 			context.Respond(&messages.NoSuchKeyError{Key: msg.Key})
 		}
 	case *messages.DeleteRequest:
-		if value, exists := state.content[int(msg.Key)]; exists {
-			log.Printf("Leaf %s contains key to be deleted. Deleting (%d, %s)", name, msg.Key, value)
-			delete(state.content, int(msg.Key))
+		if lock, locked := state.locks[int(msg.Key)]; locked {
+			logger.Debugf("Leaf %s has key %d locked by transaction %d", name, msg.Key, lock.TxId)
+			context.Respond(&messages.KeyLockedError{Key: msg.Key, TxId: lock.TxId})
+		} else if value, exists := state.content.get(int(msg.Key), nil); exists {
+			logger.Debugf("Leaf %s contains key to be deleted. Deleting (%d, %s) in version %d",
+				name,
+				msg.Key,
+				value,
+				msg.Version,
+			)
+			state.content.remove(int(msg.Key), msg.Version)
+			state.content.prune(int(msg.Key), state.snapshots)
 			context.Respond(&messages.DeleteResponse{Item: &messages.Item{Key: msg.Key, Value: value}})
 		} else {
-			log.Printf("Leaf %s does not contain key to be deleted: %d. Do nothing", name, msg.Key)
+			logger.Debugf("Leaf %s does not contain key to be deleted: %d. Do nothing", name, msg.Key)
 			context.Respond(&messages.NoSuchKeyError{Key: msg.Key})
 		}
 	case *messages.TraverseRequest:
-		log.Printf("Leaf %s responding with its sorted items", name)
-		context.Respond(&messages.TraverseResponse{Items: itemsSortedByKeys(state.content)})
+		logger.Debugf("Leaf %s responding with its sorted items", name)
+		context.Respond(&messages.TraverseResponse{Items: state.content.items(msg.Snapshot, math.MinInt64, math.MaxInt64)})
+	case *messages.RangeRequest:
+		logger.Debugf("Leaf %s responding with its sorted items from %d to %d", name, msg.From, msg.To)
+		context.Respond(&messages.RangeResponse{Items: state.content.items(msg.Snapshot, msg.From, msg.To)})
+	case *messages.PurgeExpired:
+		for _, key := range state.content.expired(msg.Now) {
+			if _, locked := state.locks[key]; locked {
+				continue
+			}
+			logger.Debugf("Leaf %s deletes expired key %d in version %d", name, key, msg.Version)
+			state.content.remove(key, msg.Version)
+			state.content.prune(key, state.snapshots)
+		}
+	case *messages.ActiveSnapshots:
+		logger.Debugf("Leaf %s drops versions not visible in snapshots %v", name, msg.Versions)
+		state.snapshots = msg.Versions
+		state.content.pruneAll(state.snapshots)
+	case *messages.TxPrepare:
+		key := int(msg.Operation.Item.Key)
+		if reason := state.prepare(msg); reason != "" {
+			logger.Debugf("Leaf %s votes to abort transaction %d: %s", name, msg.TxId, reason)
+			context.Respond(&messages.TxVote{TxId: msg.TxId, Key: int64(key), Commit: false, Reason: reason})
+		} else {
+			state.locks[key] = msg
+			logger.Debugf("Leaf %s locked key %d and votes to commit transaction %d", name, key, msg.TxId)
+			context.Respond(&messages.TxVote{TxId: msg.TxId, Key: int64(key), Commit: true})
+		}
+	case *messages.TxCommit:
+		if lock, locked := state.locks[int(msg.Key)]; locked && lock.TxId == msg.TxId {
+			item := lock.Operation.Item
+			switch lock.Operation.Type {
+			case messages.INSERT, messages.UPDATE:
+				state.content.put(int(item.Key), item.Value, msg.Version, 0)
+				logger.Debugf("Leaf %s saved (%d, %s) for transaction %d", name, item.Key, item.Value, msg.TxId)
+			case messages.DELETE:
+				state.content.remove(int(item.Key), msg.Version)
+				logger.Debugf("Leaf %s deleted key %d for transaction %d", name, item.Key, msg.TxId)
+			}
+			// Until all leafs acknowledged the commit the treeservice hands out snapshots of the version before it.
+			// Their ActiveSnapshots come from the treeservice, not the coordinator, and may arrive after the TxCommit,
+			// so the version they see is kept until the next prune.
+			state.content.prune(int(item.Key), append([]int64{msg.Version - 1}, state.snapshots...))
+			delete(state.locks, int(msg.Key))
+		}
+		context.Respond(&messages.TxAck{TxId: msg.TxId, Key: msg.Key})
+		state.splitIfTooBig(context)
+	case *messages.TxAbort:
+		if lock, locked := state.locks[int(msg.Key)]; locked && lock.TxId == msg.TxId {
+			logger.Debugf("Leaf %s releases lock on key %d of aborted transaction %d", name, msg.Key, msg.TxId)
+			delete(state.locks, int(msg.Key))
+		}
 	case *actor.Stopping:
-		log.Printf("Leaf %s stopping", context.Self().Id)
+		logger.Debugf("Leaf %s stopping", context.Self().Id)
+	}
+}
+
+// Checks whether the operation of a transaction can be applied to this leaf.
+// Returns the reason why it can't or an empty string.
+func (state *nodeActor) prepare(msg *messages.TxPrepare) string {
+	key := int(msg.Operation.Item.Key)
+	if lock, locked := state.locks[key]; locked && lock.TxId != msg.TxId {
+		return fmt.Sprintf("key %d is locked by transaction %d", key, lock.TxId)
 	}
+	_, exists := state.content.get(key, nil)
+	switch msg.Operation.Type {
+	case messages.INSERT:
+		if exists {
+			return fmt.Sprintf("key %d already exists", key)
+		}
+	case messages.DELETE, messages.UPDATE:
+		if !exists {
+			return fmt.Sprintf("there is no key %d", key)
+		}
+	}
+	return ""
+}
+
+// Splits the leaf into two new leafs if it holds more than maxSize items and becomes an internal node.
+func (state *nodeActor) splitIfTooBig(context actor.Context) {
+	// A single key can't be split, even if the leaf has room for no item
+	if state.content.size() <= state.maxSize || len(state.content) < 2 {
+		return
+	}
+	loggerOf(context).Debugf("Leaf %s too big - splitting up", context.Self().Id)
+	itemsLeft, maxLeftSideKey, itemsRight := state.content.split()
+	state.split(context, itemsLeft, maxLeftSideKey, itemsRight)
+}
+
+// Moves the lower items to a new left leaf and the upper ones to a new right leaf and becomes an internal node.
+func (state *nodeActor) split(
+	context actor.Context,
+	itemsLeft []*messages.VersionedItem,
+	maxLeftSideKey int,
+	itemsRight []*messages.VersionedItem,
+) {
+	name := context.Self().Id
+	logger := loggerOf(context)
+	locksLeft, locksRight := splitLocks(state.locks, maxLeftSideKey)
+	state.size = state.content.usage()
+	state.left = createLeaf(context, int64(state.maxSize), &messages.MultiInsert{
+		VersionedItems: itemsLeft,
+		Locks:          locksLeft,
+		Snapshots:      state.snapshots,
+		Moved:          true,
+	})
+	state.right = createLeaf(context, int64(state.maxSize), &messages.MultiInsert{
+		VersionedItems: itemsRight,
+		Locks:          locksRight,
+		Snapshots:      state.snapshots,
+		Moved:          true,
+	})
+	state.maxLeftSideKey = maxLeftSideKey
+	for key := range state.content {
+		delete(state.content, key)
+	}
+	for key := range state.locks {
+		delete(state.locks, key)
+	}
+	state.behaviour.Become(state.internalNode)
+	state.load.unpublish()
+	leafSplits.Inc()
+	nodeActors.WithLabelValues("leaf").Dec()
+	nodeActors.WithLabelValues("internal").Inc()
+	logger.Infof("Leaf %s became internalNode with maxLeftSideKey = %d", name, state.maxLeftSideKey)
 }
 
 func (state *nodeActor) internalNode(context actor.Context) {
+	logger := loggerOf(context)
 	switch msg := context.Message().(type) {
 	case *actor.Stopping:
-		log.Printf("Internal node %s stopping. Poisoning children", context.Self().Id)
+		logger.Debugf("Internal node %s stopping. Poisoning children", context.Self().Id)
 		context.Poison(state.left)
 		context.Poison(state.right)
 	case *messages.InsertRequest:
 		if int(msg.Item.Key) > state.maxLeftSideKey {
-			log.Printf("Internal node %s forwards (%d, %s) to righthand child", context.Self().Id, msg.Item.Key, msg.Item.Value)
+			logger.Debugf("Internal node %s forwards (%d, %s) to righthand child",
+				context.Self().Id,
+				msg.Item.Key,
+				msg.Item.Value,
+			)
 			context.Forward(state.right)
 		} else {
-			log.Printf("Internal node %s forwards (%d, %s) to lefthand child", context.Self().Id, msg.Item.Key, msg.Item.Value)
+			logger.Debugf("Internal node %s forwards (%d, %s) to lefthand child",
+				context.Self().Id,
+				msg.Item.Key,
+				msg.Item.Value,
+			)
 			context.Forward(state.left)
 		}
 	case *messages.SearchRequest:
 		if int(msg.Key) > state.maxLeftSideKey {
-			log.Printf("Internal node %s forwards search request for key %d to righthand child (bigger than %d)",
+			logger.Debugf("Internal node %s forwards search request for key %d to righthand child (bigger than %d)",
 				context.Self().Id,
 				msg.Key,
 				state.maxLeftSideKey,
 			)
 			context.Forward(state.right)
 		} else {
-			log.Printf("Internal node %s forwards search request for key %d to lefthand child (equal or smaller than %d)",
+			logger.Debugf("Internal node %s forwards search request for key %d to lefthand child (equal or smaller than %d)",
 				context.Self().Id,
 				msg.Key,
 				state.maxLeftSideKey,
@@ -116,14 +328,14 @@ func (state *nodeActor) internalNode(context actor.Context) {
 		}
 	case *messages.DeleteRequest:
 		if int(msg.Key) > state.maxLeftSideKey {
-			log.Printf("Internal node %s forwards delete request for key %d to righthand child (bigger than %d)",
+			logger.Debugf("Internal node %s forwards delete request for key %d to righthand child (bigger than %d)",
 				context.Self().Id,
 				msg.Key,
 				state.maxLeftSideKey,
 			)
 			context.Forward(state.right)
 		} else {
-			log.Printf("Internal node %s forwards delete request for key %d to lefthand child (equal or smaller than %d)",
+			logger.Debugf("Internal node %s forwards delete request for key %d to lefthand child (equal or smaller than %d)",
 				context.Self().Id,
 				msg.Key,
 				state.maxLeftSideKey,
@@ -131,38 +343,193 @@ func (state *nodeActor) internalNode(context actor.Context) {
 			context.Forward(state.left)
 		}
 	case *messages.TraverseRequest:
-		leftFuture := context.RequestFuture(state.left, &messages.TraverseRequest{}, 5*time.Second)
-		rightFuture := context.RequestFuture(state.right, &messages.TraverseRequest{}, 5*time.Second)
-		log.Printf("Internal node %s fires traverserequests to its children", context.Self().Id)
-		context.AwaitFuture(leftFuture, func(resLeft interface{}, errLeft error) {
-			if errLeft != nil {
-				log.Panic(errLeft)
+		logger.Debugf("Internal node %s fires traverserequests to its children", context.Self().Id)
+		request := &messages.TraverseRequest{
+			Snapshot:  msg.Snapshot,
+			RequestId: msg.RequestId,
+			Deadline:  msg.Deadline,
+		}
+		state.gather(context, request, func(items []*messages.Item) interface{} {
+			return &messages.TraverseResponse{Items: items}
+		})
+	case *messages.RangeRequest:
+		switch {
+		case msg.To <= int64(state.maxLeftSideKey):
+			logger.Debugf("Internal node %s forwards range request to lefthand child", context.Self().Id)
+			context.Forward(state.left)
+		case msg.From > int64(state.maxLeftSideKey):
+			logger.Debugf("Internal node %s forwards range request to righthand child", context.Self().Id)
+			context.Forward(state.right)
+		default:
+			logger.Debugf("Internal node %s fires rangerequests to its children", context.Self().Id)
+			state.gather(context, msg, func(items []*messages.Item) interface{} {
+				return &messages.RangeResponse{Items: items}
+			})
+		}
+	case *messages.InsertBatchRequest:
+		itemsLeft := make([]*messages.Item, 0)
+		itemsRight := make([]*messages.Item, 0)
+		for _, item := range msg.Items {
+			if int(item.Key) > state.maxLeftSideKey {
+				itemsRight = append(itemsRight, item)
+			} else {
+				itemsLeft = append(itemsLeft, item)
 			}
-			switch msgLeft := resLeft.(type) {
-			case *messages.TraverseResponse:
-				log.Printf("Left future fired by internal node %s arrived", context.Self().Id)
-				context.AwaitFuture(rightFuture, func(resRight interface{}, errRight error) {
-					if errRight != nil {
-						log.Panic(errRight)
-					}
-					log.Printf("Right future fired by internal node %s arrived", context.Self().Id)
-					switch msgRight := resRight.(type) {
-					case *messages.TraverseResponse:
-						log.Printf("Merging results of futures fired by internal node %s", context.Self().Id)
-						items := append(msgLeft.Items, msgRight.Items...)
-						sort.Slice(items, func(i, j int) bool {
-							return items[i].Key < items[j].Key
-						})
-						context.Respond(&messages.TraverseResponse{Items: items})
-					default:
-						log.Panicf("Right future fired by internal node %s arrived in unknown type", context.Self().Id)
-					}
-				})
-			default:
-				log.Panicf("Left future fired by internal node %s arrived in unknown type", context.Self().Id)
+		}
+		switch {
+		case len(itemsRight) == 0:
+			logger.Debugf("Internal node %s forwards batch of %d items to lefthand child", context.Self().Id, len(itemsLeft))
+			context.Forward(state.left)
+		case len(itemsLeft) == 0:
+			logger.Debugf("Internal node %s forwards batch of %d items to righthand child", context.Self().Id, len(itemsRight))
+			context.Forward(state.right)
+		default:
+			logger.Debugf("Internal node %s splits batch into %d items for lefthand and %d items for righthand child",
+				context.Self().Id,
+				len(itemsLeft),
+				len(itemsRight),
+			)
+			state.insertBatch(context, msg, itemsLeft, itemsRight)
+		}
+	case *messages.SizeDelta:
+		state.size.Items += msg.Items
+		state.size.Bytes += msg.Bytes
+	case *messages.ActiveSnapshots, *messages.PurgeExpired:
+		logger.Debugf("Internal node %s forwards %T to both children", context.Self().Id, msg)
+		context.Forward(state.left)
+		context.Forward(state.right)
+	case *messages.TxPrepare:
+		logger.Debugf("Internal node %s forwards prepare of transaction %d for key %d",
+			context.Self().Id,
+			msg.TxId,
+			msg.Operation.Item.Key,
+		)
+		context.Forward(state.child(msg.Operation.Item.Key))
+	case *messages.TxCommit:
+		logger.Debugf("Internal node %s forwards commit of transaction %d for key %d", context.Self().Id, msg.TxId, msg.Key)
+		context.Forward(state.child(msg.Key))
+	case *messages.TxAbort:
+		logger.Debugf("Internal node %s forwards abort of transaction %d for key %d", context.Self().Id, msg.TxId, msg.Key)
+		context.Forward(state.child(msg.Key))
+	}
+}
+
+// Sends the request to both children and responds with the merged items of their responses.
+// Error responses of the children are passed on, missing responses become a DeadlineExceededError.
+func (state *nodeActor) gather(
+	context actor.Context,
+	request interface{},
+	response func(items []*messages.Item) interface{},
+) {
+	logger := loggerOf(context)
+	leftFuture := context.RequestFuture(state.left, request, timeLeft(request))
+	rightFuture := context.RequestFuture(state.right, request, timeLeft(request))
+	context.AwaitFuture(leftFuture, func(resLeft interface{}, errLeft error) {
+		if errLeft != nil {
+			logger.Infof("Internal node %s got no response from its left child: %v", context.Self().Id, errLeft)
+			context.Respond(deadlineExceeded(request))
+			return
+		}
+		itemsLeft, ok := itemsOf(resLeft)
+		if !ok {
+			logger.Debugf("Internal node %s passes on %T of its left child", context.Self().Id, resLeft)
+			context.Respond(resLeft)
+			return
+		}
+		logger.Debugf("Left future fired by internal node %s arrived", context.Self().Id)
+		context.AwaitFuture(rightFuture, func(resRight interface{}, errRight error) {
+			if errRight != nil {
+				logger.Infof("Internal node %s got no response from its right child: %v", context.Self().Id, errRight)
+				context.Respond(deadlineExceeded(request))
+				return
 			}
+			logger.Debugf("Right future fired by internal node %s arrived", context.Self().Id)
+			itemsRight, ok := itemsOf(resRight)
+			if !ok {
+				logger.Debugf("Internal node %s passes on %T of its right child", context.Self().Id, resRight)
+				context.Respond(resRight)
+				return
+			}
+			logger.Debugf("Merging results of futures fired by internal node %s", context.Self().Id)
+			items := append(itemsLeft, itemsRight...)
+			sort.Slice(items, func(i, j int) bool {
+				return items[i].Key < items[j].Key
+			})
+			context.Respond(response(items))
+		})
+	})
+}
+
+// Sends each child its part of the batch and responds with the combined result.
+// Error responses of the children are passed on, missing responses become a DeadlineExceededError.
+func (state *nodeActor) insertBatch(
+	context actor.Context,
+	msg *messages.InsertBatchRequest,
+	itemsLeft, itemsRight []*messages.Item,
+) {
+	logger := loggerOf(context)
+	leftFuture := context.RequestFuture(
+		state.left,
+		&messages.InsertBatchRequest{
+			Items:     itemsLeft,
+			Version:   msg.Version,
+			RequestId: msg.RequestId,
+			Deadline:  msg.Deadline,
+		},
+		timeLeft(msg),
+	)
+	rightFuture := context.RequestFuture(
+		state.right,
+		&messages.InsertBatchRequest{
+			Items:     itemsRight,
+			Version:   msg.Version,
+			RequestId: msg.RequestId,
+			Deadline:  msg.Deadline,
+		},
+		timeLeft(msg),
+	)
+	context.AwaitFuture(leftFuture, func(resLeft interface{}, errLeft error) {
+		context.AwaitFuture(rightFuture, func(resRight interface{}, errRight error) {
+			if errLeft != nil || errRight != nil {
+				logger.Infof("Internal node %s misses responses of its children to a batch", context.Self().Id)
+				context.Respond(deadlineExceeded(msg))
+				return
+			}
+			left, okLeft := resLeft.(*messages.InsertBatchResponse)
+			right, okRight := resRight.(*messages.InsertBatchResponse)
+			if !okLeft {
+				context.Respond(resLeft)
+				return
+			}
+			if !okRight {
+				context.Respond(resRight)
+				return
+			}
+			context.Respond(&messages.InsertBatchResponse{
+				Inserted: left.Inserted + right.Inserted,
+				Existing: append(left.Existing, right.Existing...),
+				Locked:   append(left.Locked, right.Locked...),
+			})
 		})
+	})
+}
+
+func itemsOf(response interface{}) ([]*messages.Item, bool) {
+	switch msg := response.(type) {
+	case *messages.TraverseResponse:
+		return msg.Items, true
+	case *messages.RangeResponse:
+		return msg.Items, true
 	}
+	return nil, false
+}
+
+// Returns the child responsible for the given key.
+func (state *nodeActor) child(key int64) *actor.PID {
+	if int(key) > state.maxLeftSideKey {
+		return state.right
+	}
+	return state.left
 }
 
 func NodeActorProducer() actor.Actor {
@@ -171,26 +538,22 @@ func NodeActorProducer() actor.Actor {
 	return node
 }
 
-func createLeaf(context actor.Context, maxsize int64, items []*messages.Item) *actor.PID {
-	pid := context.Spawn(actor.PropsFromProducer(NodeActorProducer))
+func createLeaf(context actor.Context, maxsize int64, content *messages.MultiInsert) *actor.PID {
+	pid := context.Spawn(NodeProps())
 	context.Send(pid, &messages.CreateTreeRequest{MaxSize: maxsize})
-	context.Send(pid, &messages.MultiInsert{Items: items})
+	context.Send(pid, content)
 	return pid
 }
 
-func split(content map[int]string) ([]*messages.Item, int, []*messages.Item) {
-	items := itemsSortedByKeys(content)
-	mid := len(items) / 2
-	return items[:mid], int(items[mid-1].Key), items[mid:]
-}
-
-func itemsSortedByKeys(content map[int]string) []*messages.Item {
-	items := make([]*messages.Item, 0)
-	for key, value := range content {
-		items = append(items, &messages.Item{Key: int64(key), Value: value})
+func splitLocks(locks map[int]*messages.TxPrepare, maxLeftSideKey int) ([]*messages.TxPrepare, []*messages.TxPrepare) {
+	locksLeft := make([]*messages.TxPrepare, 0)
+	locksRight := make([]*messages.TxPrepare, 0)
+	for key, lock := range locks {
+		if key > maxLeftSideKey {
+			locksRight = append(locksRight, lock)
+		} else {
+			locksLeft = append(locksLeft, lock)
+		}
 	}
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].Key < items[j].Key
-	})
-	return items
+	return locksLeft, locksRight
 }
@@ -0,0 +1,161 @@
+package tree
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+)
+
+// Actor coordinating the two-phase commit of one transaction. Implements actor.Actor.
+type txCoordinatorActor struct {
+	root       *actor.PID
+	client     *actor.PID
+	requestID  string
+	deadline   int64
+	txID       int64
+	version    int64
+	operations []*messages.TxOperation
+	timeout    time.Duration
+	votes      map[int64]bool
+	acks       int
+	reason     string
+	behaviour  actor.Behavior
+}
+
+// Returns a logger with the fields txId and requestId of the CommitTxRequest.
+func (state *txCoordinatorActor) logger() *logrus.Entry {
+	return logrus.WithFields(logrus.Fields{"txId": state.txID, "requestId": state.requestID})
+}
+
+// Prefixes the names of the spans of the coordinator.
+func (state *txCoordinatorActor) Kind() string {
+	return "coordinator"
+}
+
+// Receives messages.
+func (state *txCoordinatorActor) Receive(context actor.Context) {
+	state.behaviour.Receive(context)
+}
+
+// Behaviour while collecting the votes of the involved leafs
+func (state *txCoordinatorActor) preparing(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *messages.CommitTxRequest:
+		state.client = context.Sender()
+		state.requestID = msg.RequestId
+		state.deadline = msg.Deadline
+		if len(state.operations) == 0 {
+			state.logger().Infof("Coordinator of transaction %d has nothing to commit", state.txID)
+			state.finish(context, &messages.CommitTxResponse{TxId: state.txID})
+			return
+		}
+		state.logger().Debugf("Coordinator of transaction %d sends prepare for %d operations",
+			state.txID,
+			len(state.operations),
+		)
+		for _, operation := range state.operations {
+			context.Request(state.root, &messages.TxPrepare{
+				TxId:      state.txID,
+				Operation: operation,
+				RequestId: state.requestID,
+			})
+		}
+		context.SetReceiveTimeout(state.timeout)
+	case *messages.TxVote:
+		state.votes[msg.Key] = msg.Commit
+		if !msg.Commit && state.reason == "" {
+			state.reason = msg.Reason
+		}
+		if len(state.votes) < len(state.operations) {
+			return
+		}
+		context.CancelReceiveTimeout()
+		if state.reason != "" {
+			state.abort(context)
+			return
+		}
+		state.logger().Debugf("All leafs voted to commit transaction %d - committing", state.txID)
+		state.behaviour.Become(state.committing)
+		context.Request(context.Parent(), &messages.TxVersionRequest{TxId: state.txID, RequestId: state.requestID})
+		context.SetReceiveTimeout(state.timeout)
+	case *actor.ReceiveTimeout:
+		state.reason = "timeout while waiting for votes"
+		state.abort(context)
+	}
+}
+
+// Behaviour after the decision to commit has been made. The operations are committed once the treeservice
+// assigned the version. Without version within the timeout the transaction is still aborted. Once the leafs got the
+// TxCommit it can't be undone, so without all acknowledgements within the timeout the coordinator gives up and
+// answers with a DeadlineExceededError, as it doesn't know whether all operations were applied.
+func (state *txCoordinatorActor) committing(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *messages.TxVersionResponse:
+		state.version = msg.Version
+		for _, operation := range state.operations {
+			context.Request(state.root, &messages.TxCommit{
+				TxId:      state.txID,
+				Key:       operation.Item.Key,
+				Version:   state.version,
+				RequestId: state.requestID,
+			})
+		}
+	case *messages.TxAck:
+		state.acks++
+		if state.acks == len(state.operations) {
+			state.logger().Infof("Transaction %d committed", state.txID)
+			state.finish(context, &messages.CommitTxResponse{TxId: state.txID, Operations: state.operations})
+		}
+	case *actor.ReceiveTimeout:
+		if state.version == 0 {
+			state.reason = "timeout while waiting for the version"
+			state.abort(context)
+			return
+		}
+		state.logger().Warnf("Coordinator of transaction %d gives up, only %d of %d leafs acknowledged the commit",
+			state.txID,
+			state.acks,
+			len(state.operations),
+		)
+		state.finish(context, &messages.DeadlineExceededError{Deadline: state.deadline})
+	}
+}
+
+// Releases all locks held for this transaction and informs the client.
+func (state *txCoordinatorActor) abort(context actor.Context) {
+	state.logger().Infof("Aborting transaction %d: %s", state.txID, state.reason)
+	for _, operation := range state.operations {
+		context.Send(state.root, &messages.TxAbort{TxId: state.txID, Key: operation.Item.Key, RequestId: state.requestID})
+	}
+	state.finish(context, &messages.TxAbortedError{TxId: state.txID, Reason: state.reason})
+}
+
+func (state *txCoordinatorActor) finish(context actor.Context, response interface{}) {
+	context.Send(state.client, response)
+	context.Stop(context.Self())
+}
+
+// Returns a producer for a coordinator committing the given operations on the tree with the given root.
+// All operations are applied in the version the parent of the coordinator responds with to a TxVersionRequest.
+// The coordinator starts when it receives the CommitTxRequest and answers its sender.
+func TxCoordinatorProducer(
+	root *actor.PID,
+	txID int64,
+	operations []*messages.TxOperation,
+	timeout time.Duration,
+) actor.Producer {
+	return func() actor.Actor {
+		coordinator := &txCoordinatorActor{
+			root:       root,
+			txID:       txID,
+			operations: operations,
+			timeout:    timeout,
+			votes:      make(map[int64]bool),
+			behaviour:  actor.NewBehavior(),
+		}
+		coordinator.behaviour.Become(coordinator.preparing)
+		return coordinator
+	}
+}
@@ -0,0 +1,177 @@
+package tree
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Versions of the items of a leaf. The versions of each key are sorted ascending.
+// Older versions are only kept as long as an active snapshot can see them.
+type versionedContent map[int][]*messages.Version
+
+// Returns the value of the key visible in the snapshot or the latest value if snapshot is nil.
+// Expired values are not returned as latest value. Snapshots see them until they are purged.
+func (content versionedContent) get(key int, snapshot *messages.Snapshot) (string, bool) {
+	if version := content.visible(key, snapshot); version != nil {
+		return version.Value, true
+	}
+	return "", false
+}
+
+// Returns the version of the key visible in the snapshot or the latest version if snapshot is nil.
+// Returns nil if there is no such version or it is deleted.
+func (content versionedContent) visible(key int, snapshot *messages.Snapshot) *messages.Version {
+	versions := content[key]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if snapshot == nil {
+			if versions[i].Deleted || expired(versions[i], now()) {
+				return nil
+			}
+			return versions[i]
+		}
+		if versions[i].Version <= snapshot.Version {
+			if versions[i].Deleted {
+				return nil
+			}
+			return versions[i]
+		}
+	}
+	return nil
+}
+
+func (content versionedContent) put(key int, value string, version, expiresAt int64) {
+	content[key] = append(content[key], &messages.Version{Version: version, Value: value, ExpiresAt: expiresAt})
+}
+
+func (content versionedContent) remove(key int, version int64) {
+	content[key] = append(content[key], &messages.Version{Version: version, Deleted: true})
+}
+
+// Returns the number of keys with a value in the latest version.
+func (content versionedContent) size() int {
+	size := 0
+	for key := range content {
+		if _, exists := content.get(key, nil); exists {
+			size++
+		}
+	}
+	return size
+}
+
+// Returns the number of keys whose latest version isn't deleted and the total length of their values.
+// Expired values count until they are purged.
+func (content versionedContent) usage() messages.SizeDelta {
+	usage := messages.SizeDelta{}
+	for _, versions := range content {
+		if latest := versions[len(versions)-1]; !latest.Deleted {
+			usage.Items++
+			usage.Bytes += int64(len(latest.Value))
+		}
+	}
+	return usage
+}
+
+// Returns the items with from <= key <= to visible in the snapshot sorted by key.
+func (content versionedContent) items(snapshot *messages.Snapshot, from, to int64) []*messages.Item {
+	items := make([]*messages.Item, 0)
+	for key := range content {
+		if int64(key) < from || int64(key) > to {
+			continue
+		}
+		if version := content.visible(key, snapshot); version != nil {
+			items = append(items, &messages.Item{Key: int64(key), Value: version.Value, ExpiresAt: version.ExpiresAt})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Key < items[j].Key
+	})
+	return items
+}
+
+// Drops all versions of the key which are neither the latest one nor visible in one of the snapshots.
+func (content versionedContent) prune(key int, snapshots []int64) {
+	versions := content[key]
+	kept := make([]*messages.Version, 0, len(versions))
+	for i, version := range versions {
+		if i == len(versions)-1 || visibleInAny(version, versions[i+1], snapshots) {
+			kept = append(kept, version)
+		}
+	}
+	// A deleted version without older ones hides nothing
+	for len(kept) > 0 && kept[0].Deleted {
+		kept = kept[1:]
+	}
+	if len(kept) == 0 {
+		delete(content, key)
+	} else {
+		content[key] = kept
+	}
+}
+
+// Returns the keys whose latest value expired at the given time.
+func (content versionedContent) expired(at int64) []int {
+	keys := make([]int, 0)
+	for key, versions := range content {
+		latest := versions[len(versions)-1]
+		if !latest.Deleted && expired(latest, at) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (content versionedContent) pruneAll(snapshots []int64) {
+	for key := range content {
+		content.prune(key, snapshots)
+	}
+}
+
+// Splits the keys in two halves. Returns the lower half, its biggest key and the upper half.
+func (content versionedContent) split() ([]*messages.VersionedItem, int, []*messages.VersionedItem) {
+	return content.splitWeighted(func(int) int { return 1 })
+}
+
+// Splits the sorted keys where the lower half reaches half of the total weight, keeping at least one key
+// on each side. Needs at least two keys. Returns the lower half, its biggest key and the upper half.
+func (content versionedContent) splitWeighted(weight func(key int) int) (
+	[]*messages.VersionedItem,
+	int,
+	[]*messages.VersionedItem,
+) {
+	items := make([]*messages.VersionedItem, 0, len(content))
+	total := 0
+	for key, versions := range content {
+		items = append(items, &messages.VersionedItem{Key: int64(key), Versions: versions})
+		total += weight(key)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Key < items[j].Key
+	})
+	mid, sum := 1, weight(int(items[0].Key))
+	for mid < len(items)-1 && 2*(sum+weight(int(items[mid].Key))) <= total {
+		sum += weight(int(items[mid].Key))
+		mid++
+	}
+	return items[:mid], int(items[mid-1].Key), items[mid:]
+}
+
+func expired(version *messages.Version, at int64) bool {
+	return version.ExpiresAt != 0 && version.ExpiresAt <= at
+}
+
+// Returns the current unix time in milliseconds.
+func now() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// Checks whether a version superseded by next is the one seen by one of the snapshots.
+func visibleInAny(version, next *messages.Version, snapshots []int64) bool {
+	for _, snapshot := range snapshots {
+		if version.Version <= snapshot && snapshot < next.Version {
+			return true
+		}
+	}
+	return false
+}
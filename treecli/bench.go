@@ -0,0 +1,331 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+var benchOperations = []string{"insert", "search", "delete", "traverse"}
+
+// Settings of a benchmark run.
+type benchConfig struct {
+	clients      int
+	duration     time.Duration
+	keys         int64
+	distribution string
+	mix          map[string]int
+	prefill      int64
+}
+
+// Parses a mix like insert=30,search=60,delete=10 into the weights of the operations.
+func parseMix(mix string) (map[string]int, error) {
+	weights := make(map[string]int)
+	total := 0
+	for _, part := range strings.Split(mix, ",") {
+		pair := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("expected operation=weight but got %s", part)
+		}
+		known := false
+		for _, operation := range benchOperations {
+			known = known || operation == pair[0]
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown operation %s, expected one of %v", pair[0], benchOperations)
+		}
+		weight, err := strconv.Atoi(pair[1])
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight %s for %s", pair[1], pair[0])
+		}
+		weights[pair[0]] = weight
+		total += weight
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("mix %s contains no operation", mix)
+	}
+	return weights, nil
+}
+
+// Returns the next key to use. Each client has its own generator.
+type keyGenerator func() int64
+
+func newKeyGenerator(distribution string, keys int64, rng *rand.Rand, sequence *int64) (keyGenerator, error) {
+	switch distribution {
+	case "uniform":
+		return func() int64 {
+			return rng.Int63n(keys)
+		}, nil
+	case "zipfian":
+		zipf := rand.NewZipf(rng, 1.1, 1, uint64(keys-1))
+		return func() int64 {
+			return int64(zipf.Uint64())
+		}, nil
+	case "sequential":
+		return func() int64 {
+			return (atomic.AddInt64(sequence, 1) - 1) % keys
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown distribution %s, expected uniform, zipfian or sequential", distribution)
+}
+
+// Latencies and errors measured by one client.
+type benchResult struct {
+	latencies map[string][]time.Duration
+	errors    map[string]int
+}
+
+// Actor sending one request after the other until the benchmark is over. Implements actor.Actor.
+type benchClientActor struct {
+	remotePid   *actor.PID
+	credentials *messages.Credentials
+	rng         *rand.Rand
+	nextKey     keyGenerator
+	mix         map[string]int
+	deadline    time.Time
+	operation   string
+	sentAt      time.Time
+	result      *benchResult
+	done        chan<- *benchResult
+}
+
+func (state *benchClientActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		context.SetReceiveTimeout(responseTimeout())
+		state.send(context)
+	case *actor.ReceiveTimeout:
+		log.Printf("Client got no response to %s within %s", state.operation, responseTimeout())
+		state.result.errors[state.operation]++
+		state.finish(context)
+	default:
+		response, exitCode := tableOf(msg)
+		if response == nil {
+			return
+		}
+		latency := time.Since(state.sentAt)
+		state.result.latencies[state.operation] = append(state.result.latencies[state.operation], latency)
+		// Existing keys on insert and missing keys on delete are expected and no errors
+		if exitCode != exitOK && exitCode != exitKeyAlreadyExists && exitCode != exitNoSuchKey {
+			state.result.errors[state.operation]++
+		}
+		if time.Now().After(state.deadline) {
+			state.finish(context)
+			return
+		}
+		state.send(context)
+	}
+}
+
+func (state *benchClientActor) send(context actor.Context) {
+	state.operation = state.chooseOperation()
+	var message interface{}
+	switch state.operation {
+	case "insert":
+		key := state.nextKey()
+		message = &messages.InsertRequest{
+			Credentials: state.credentials,
+			Item:        &messages.Item{Key: key, Value: strconv.FormatInt(key, 10)},
+		}
+	case "search":
+		message = &messages.SearchRequest{Credentials: state.credentials, Key: state.nextKey()}
+	case "delete":
+		message = &messages.DeleteRequest{Credentials: state.credentials, Key: state.nextKey()}
+	case "traverse":
+		message = &messages.TraverseRequest{Credentials: state.credentials}
+	}
+	state.sentAt = time.Now()
+	context.Request(state.remotePid, message)
+}
+
+func (state *benchClientActor) chooseOperation() string {
+	total := 0
+	for _, weight := range state.mix {
+		total += weight
+	}
+	choice := state.rng.Intn(total)
+	for _, operation := range benchOperations {
+		if choice < state.mix[operation] {
+			return operation
+		}
+		choice -= state.mix[operation]
+	}
+	return benchOperations[0]
+}
+
+func (state *benchClientActor) finish(context actor.Context) {
+	context.CancelReceiveTimeout()
+	state.done <- state.result
+	context.Stop(context.Self())
+}
+
+// Creates a tree with the given maxSize, prefills it, runs the clients against it and deletes it afterwards.
+// Returns the merged results of all clients.
+func benchTree(
+	context *actor.RootContext,
+	remotePid *actor.PID,
+	config *benchConfig,
+	maxSize int64,
+) (*benchResult, time.Duration, error) {
+	res, err := request(context, remotePid, &messages.CreateTreeRequest{MaxSize: maxSize})
+	if err != nil {
+		return nil, 0, err
+	}
+	created, ok := res.(*messages.CreateTreeResponse)
+	if !ok {
+		return nil, 0, unexpectedResponse(res)
+	}
+	credentials := created.Credentials
+	defer func() {
+		_, _ = request(context, remotePid, &messages.DeleteTreeRequest{Credentials: credentials})
+	}()
+
+	// Every second key so inserts as well as deletes find keys to work on
+	for from := int64(0); from < config.prefill; from += importBatchSize {
+		batch := make([]*messages.Item, 0, importBatchSize)
+		for i := from; i < from+importBatchSize && i < config.prefill; i++ {
+			key := i * 2 % config.keys
+			batch = append(batch, &messages.Item{Key: key, Value: strconv.FormatInt(key, 10)})
+		}
+		if _, err := request(context, remotePid, &messages.InsertBatchRequest{
+			Credentials: credentials,
+			Items:       batch,
+		}); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	done := make(chan *benchResult, config.clients)
+	var sequence int64
+	start := time.Now()
+	for i := 0; i < config.clients; i++ {
+		rng := rand.New(rand.NewSource(start.UnixNano() + int64(i)))
+		nextKey, err := newKeyGenerator(config.distribution, config.keys, rng, &sequence)
+		if err != nil {
+			return nil, 0, err
+		}
+		client := &benchClientActor{
+			remotePid:   remotePid,
+			credentials: credentials,
+			rng:         rng,
+			nextKey:     nextKey,
+			mix:         config.mix,
+			deadline:    start.Add(config.duration),
+			result: &benchResult{
+				latencies: make(map[string][]time.Duration),
+				errors:    make(map[string]int),
+			},
+			done: done,
+		}
+		context.Spawn(actor.PropsFromProducer(func() actor.Actor {
+			return client
+		}).WithSenderMiddleware(withDeadline))
+	}
+	merged := &benchResult{latencies: make(map[string][]time.Duration), errors: make(map[string]int)}
+	for i := 0; i < config.clients; i++ {
+		result := <-done
+		for operation, latencies := range result.latencies {
+			merged.latencies[operation] = append(merged.latencies[operation], latencies...)
+		}
+		for operation, errors := range result.errors {
+			merged.errors[operation] += errors
+		}
+	}
+	return merged, time.Since(start), nil
+}
+
+// Returns the latency below which the given fraction of the sorted latencies lie.
+func percentile(sorted []time.Duration, fraction float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := int(math.Ceil(fraction*float64(len(sorted)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return sorted[index]
+}
+
+func sortedLatencies(latencies []time.Duration) []time.Duration {
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
+
+func logLatencies(name string, latencies []time.Duration, errors int, elapsed time.Duration) {
+	sorted := sortedLatencies(latencies)
+	log.Printf("%-9s %8d ops %10.1f ops/s  p50 %-10s p90 %-10s p99 %-10s max %-10s errors %d",
+		name,
+		len(sorted),
+		float64(len(sorted))/elapsed.Seconds(),
+		percentile(sorted, 0.5).Round(time.Microsecond),
+		percentile(sorted, 0.9).Round(time.Microsecond),
+		percentile(sorted, 0.99).Round(time.Microsecond),
+		percentile(sorted, 1).Round(time.Microsecond),
+		errors,
+	)
+}
+
+// Runs the benchmark once per maxSize and logs throughput and latency percentiles of each run.
+func runBench(context *actor.RootContext, remotePid *actor.PID, config *benchConfig, maxSizes []int64) error {
+	type summary struct {
+		maxSize    int64
+		throughput float64
+		p50, p99   time.Duration
+	}
+	summaries := make([]summary, 0, len(maxSizes))
+	for _, maxSize := range maxSizes {
+		log.Printf("Benchmarking maxSize %d with %d clients for %s, %s keys in [0, %d), prefilled %d",
+			maxSize,
+			config.clients,
+			config.duration,
+			config.distribution,
+			config.keys,
+			config.prefill,
+		)
+		result, elapsed, err := benchTree(context, remotePid, config, maxSize)
+		if err != nil {
+			return err
+		}
+		all := make([]time.Duration, 0)
+		errors := 0
+		for _, operation := range benchOperations {
+			if latencies, exists := result.latencies[operation]; exists {
+				logLatencies(operation, latencies, result.errors[operation], elapsed)
+				all = append(all, latencies...)
+			}
+			errors += result.errors[operation]
+		}
+		logLatencies("total", all, errors, elapsed)
+		sorted := sortedLatencies(all)
+		summaries = append(summaries, summary{
+			maxSize:    maxSize,
+			throughput: float64(len(sorted)) / elapsed.Seconds(),
+			p50:        percentile(sorted, 0.5),
+			p99:        percentile(sorted, 0.99),
+		})
+	}
+	if len(summaries) > 1 {
+		log.Printf("Comparison of maxSize settings:")
+		for _, s := range summaries {
+			log.Printf("maxSize %-6d %10.1f ops/s  p50 %-10s p99 %s",
+				s.maxSize,
+				s.throughput,
+				s.p50.Round(time.Microsecond),
+				s.p99.Round(time.Microsecond),
+			)
+		}
+	}
+	return nil
+}
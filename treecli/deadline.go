@@ -0,0 +1,37 @@
+package main
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Time the treeservice has for each request, set with --timeout.
+var timeout = 60 * time.Second
+
+// treecli waits this much longer than the deadline of a request, so the DeadlineExceededError of the treeservice
+// arrives before treecli gives up itself.
+const deadlineGrace = time.Second
+
+// Time treecli waits for a response.
+func responseTimeout() time.Duration {
+	return timeout + deadlineGrace
+}
+
+// Sender middleware setting the deadline of each request without one to timeout from now.
+func withDeadline(next actor.SenderFunc) actor.SenderFunc {
+	return func(c actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		if msg, ok := envelope.Message.(messages.Deadlined); ok && msg.GetDeadline() == 0 {
+			msg.SetDeadline(messages.MillisOf(time.Now().Add(timeout)))
+		}
+		next(c, target, envelope)
+	}
+}
+
+// Removes the deadline of the request, so withDeadline sets a new one when it is sent again.
+func resetDeadline(message interface{}) {
+	if msg, ok := message.(messages.Deadlined); ok {
+		msg.SetDeadline(0)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Sender middleware giving each mutating request without idempotency key a random one if --retries allows retries,
+// so sending the same message again is safe: the treeservice answers with the response to the first one instead of
+// applying it twice. Without retries the treeservice needn't keep the response.
+func withIdempotencyKey(next actor.SenderFunc) actor.SenderFunc {
+	return func(c actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		if retries <= 0 {
+			next(c, target, envelope)
+			return
+		}
+		switch msg := envelope.Message.(type) {
+		case *messages.InsertRequest:
+			if msg.IdempotencyKey == "" {
+				msg.IdempotencyKey = newIdempotencyKey()
+			}
+		case *messages.InsertBatchRequest:
+			if msg.IdempotencyKey == "" {
+				msg.IdempotencyKey = newIdempotencyKey()
+			}
+		case *messages.DeleteRequest:
+			if msg.IdempotencyKey == "" {
+				msg.IdempotencyKey = newIdempotencyKey()
+			}
+		case *messages.CommitTxRequest:
+			if msg.IdempotencyKey == "" {
+				msg.IdempotencyKey = newIdempotencyKey()
+			}
+		}
+		next(c, target, envelope)
+	}
+}
+
+func newIdempotencyKey() string {
+	key := make([]byte, 16)
+	_, _ = rand.Read(key)
+	return fmt.Sprintf("%x", key)
+}
@@ -5,86 +5,233 @@ import (
 	"log"
 	"os"
 	"strconv"
-	"sync"
+	"strings"
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/remote"
 	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/remotetls"
 	"github.com/urfave/cli"
 )
 
-const timeout = 60 * time.Second
+// Time treecli waits for the treeservice to activate its remote actor in each attempt to connect.
+const activationTimeout = 5 * time.Second
 
 const globalFlagID = "id"
 const globalFlagToken = "token"
+const globalFlagSnapshot = "snapshot"
 
-func assertCredentialsExist(c *cli.Context) {
+// Returns a usage error if --id or --token is missing.
+func checkCredentials(c *cli.Context) error {
 	if !c.GlobalIsSet(globalFlagID) || !c.GlobalIsSet(globalFlagToken) {
-		log.Panic("Missing credentials.")
+		return usageError(c, "--%s and --%s of the tree are required", globalFlagID, globalFlagToken)
 	}
+	return nil
 }
 
+// Parses the argument at index i as integer. Returns a usage error naming the argument otherwise.
+func intArg(c *cli.Context, i int, name string) (int64, error) {
+	value, err := strconv.ParseInt(c.Args().Get(i), 10, 64)
+	if err != nil {
+		return 0, usageError(c, "%s must be an integer, got %q", name, c.Args().Get(i))
+	}
+	return value, nil
+}
+
+// Sends the request, retrying it if that is safe, and prints the response.
+// Without response the printer prints a NoResponse error.
 func requestAndWait(
 	context *actor.RootContext,
-	wg *sync.WaitGroup,
 	remotePid *actor.PID,
-	pid *actor.PID,
+	printer *responsePrinter,
 	message interface{},
 ) {
-	wg.Add(1)
-	context.RequestWithCustomSender(remotePid, message, pid)
-	wg.Wait()
+	res, err := requestWithRetries(context, remotePid, message)
+	if err != nil {
+		printer.printNoResponse(err)
+		return
+	}
+	printer.print(res)
 }
 
-type treeCliActor struct {
-	wg *sync.WaitGroup
+func stageAndWait(
+	c *cli.Context,
+	context *actor.RootContext,
+	remotePid *actor.PID,
+	printer *responsePrinter,
+	operationType messages.TxOperation_Type,
+) error {
+	if err := checkCredentials(c); err != nil {
+		return err
+	}
+	txID, err := intArg(c, 0, "txId")
+	if err != nil {
+		return err
+	}
+	key, err := intArg(c, 1, "key")
+	if err != nil {
+		return err
+	}
+	requestAndWait(context, remotePid, printer, &messages.StageTxRequest{
+		Credentials: credentials(c),
+		TxId:        txID,
+		Operation: &messages.TxOperation{
+			Type: operationType,
+			Item: &messages.Item{Key: key, Value: c.Args().Get(2)},
+		},
+	})
+	return nil
 }
 
-func (state *treeCliActor) Receive(c actor.Context) {
-	switch msg := c.Message().(type) {
+// Prints a response of the treeservice. Returns false if the message is no response.
+func printResponse(message interface{}) bool {
+	switch msg := message.(type) {
 	case *messages.NoSuchTreeError:
-		c.Stop(c.Self())
 		log.Printf("No tree with id %d", msg.Id)
 	case *messages.NoSuchKeyError:
-		c.Stop(c.Self())
 		log.Printf("Tree contains no key %d", msg.Key)
 	case *messages.InvalidTokenError:
-		c.Stop(c.Self())
 		log.Printf("Invalid token %s for tree %d", msg.Credentials.Token, msg.Credentials.Id)
 	case *messages.KeyAlreadyExistsError:
-		c.Stop(c.Self())
 		log.Printf("Tree already contains item (%d, %s)", msg.Item.Key, msg.Item.Value)
 	case *messages.CreateTreeResponse:
-		c.Stop(c.Self())
 		log.Printf("id: %d, token: %s", msg.Credentials.Id, msg.Credentials.Token)
 	case *messages.InsertResponse:
-		c.Stop(c.Self())
 		log.Printf("(%d, %s) successfully inserted", msg.Item.Key, msg.Item.Value)
 	case *messages.SearchResponse:
-		c.Stop(c.Self())
 		log.Printf("Found item (%d, %s)", msg.Item.Key, msg.Item.Value)
 	case *messages.DeleteResponse:
-		c.Stop(c.Self())
 		log.Printf("Successfully deleted item (%d, %s) from tree", msg.Item.Key, msg.Item.Value)
 	case *messages.TraverseResponse:
 		for _, item := range msg.Items {
 			log.Printf("(%d, %s)", item.Key, item.Value)
 		}
-		c.Stop(c.Self())
-	case *actor.Stopped:
-		state.wg.Done()
+	case *messages.RangeResponse:
+		for _, item := range msg.Items {
+			log.Printf("(%d, %s)", item.Key, item.Value)
+		}
+	case *messages.NoSuchSnapshotError:
+		log.Printf("No snapshot with id %d", msg.SnapshotId)
+	case *messages.SnapshotResponse:
+		log.Printf("snapshot: %d", msg.SnapshotId)
+	case *messages.ReleaseSnapshotResponse:
+		log.Printf("Successfully released snapshot %d", msg.SnapshotId)
 	case *messages.DeleteTreeResponse:
-		c.Stop(c.Self())
 		log.Printf("Successfully deleted tree %d", msg.Credentials.Id)
+	case *messages.TreeInfoResponse:
+		expiresAt := "never"
+		if msg.ExpiresAt != 0 {
+			expiresAt = time.Unix(0, msg.ExpiresAt*int64(time.Millisecond)).Format(time.RFC3339)
+		}
+		log.Printf("Tree %d has maxSize %d, expires at: %s, idle timeout: %s",
+			msg.Credentials.Id,
+			msg.MaxSize,
+			expiresAt,
+			time.Duration(msg.IdleTimeout)*time.Millisecond,
+		)
+		log.Printf("It holds %d items with %d bytes, limits: %s", msg.Items, msg.Bytes, quotaOf(msg.Quota))
+	case *messages.CloneTreeResponse:
+		log.Printf("Cloned %d items of tree %d", msg.ItemCount, msg.Source.Id)
+		log.Printf("id: %d, token: %s", msg.Credentials.Id, msg.Credentials.Token)
+	case *messages.SetTreeExpiryResponse:
+		expiresAt := "never"
+		if msg.ExpiresAt != 0 {
+			expiresAt = time.Unix(0, msg.ExpiresAt*int64(time.Millisecond)).Format(time.RFC3339)
+		}
+		log.Printf("Tree %d expires at: %s, idle timeout: %s",
+			msg.Credentials.Id,
+			expiresAt,
+			time.Duration(msg.IdleTimeout)*time.Millisecond,
+		)
+	case *messages.KeyLockedError:
+		log.Printf("Key %d is locked by transaction %d", msg.Key, msg.TxId)
+	case *messages.DeadlineExceededError:
+		log.Printf("Deadline %s exceeded",
+			time.Unix(0, msg.Deadline*int64(time.Millisecond)).Format(time.RFC3339Nano),
+		)
+	case *messages.QuotaExceededError:
+		log.Printf("Quota of %s exceeded, %s is %d but %d requested", msg.Scope, msg.Limit, msg.Max, msg.Requested)
+	case *messages.RateLimitedError:
+		log.Printf("Rate limit of %s %s exceeded, retry after %s",
+			msg.Scope,
+			msg.Key,
+			time.Duration(msg.RetryAfter)*time.Millisecond,
+		)
+	case *messages.OverloadedError:
+		log.Printf("Node %s is overloaded, its mailbox holds %d messages", msg.Node, msg.Capacity)
+	case *messages.ShuttingDownError:
+		log.Printf("The treeservice is shutting down")
+	case *messages.InvalidRequestError:
+		log.Printf("The treeservice rejected the request: %s", msg.Reason)
+	case *messages.NoSuchTxError:
+		log.Printf("No transaction with id %d", msg.TxId)
+	case *messages.TxAbortedError:
+		log.Printf("Transaction %d aborted: %s", msg.TxId, msg.Reason)
+	case *messages.BeginTxResponse:
+		log.Printf("Began transaction %d on tree %d", msg.TxId, msg.Credentials.Id)
+	case *messages.StageTxResponse:
+		log.Printf("Staged %s of (%d, %s) in transaction %d",
+			msg.Operation.Type,
+			msg.Operation.Item.Key,
+			msg.Operation.Item.Value,
+			msg.TxId,
+		)
+	case *messages.CommitTxResponse:
+		log.Printf("Successfully committed transaction %d", msg.TxId)
+		for _, operation := range msg.Operations {
+			log.Printf("%s (%d, %s)", operation.Type, operation.Item.Key, operation.Item.Value)
+		}
+	case *messages.AbortTxResponse:
+		log.Printf("Successfully aborted transaction %d", msg.TxId)
+	default:
+		return false
+	}
+	return true
+}
+
+// Describes the limits of a quota, those which are 0 are left out.
+func quotaOf(quota *messages.Quota) string {
+	var limits []string
+	for _, limit := range []struct {
+		name  string
+		value int64
+	}{
+		{"max items", quota.GetMaxItems()},
+		{"max value bytes", quota.GetMaxValueBytes()},
+		{"max total bytes", quota.GetMaxTotalBytes()},
+	} {
+		if limit.value != 0 {
+			limits = append(limits, fmt.Sprintf("%s %d", limit.name, limit.value))
+		}
+	}
+	if len(limits) == 0 {
+		return "none"
+	}
+	return strings.Join(limits, ", ")
+}
+
+func credentials(c *cli.Context) *messages.Credentials {
+	return &messages.Credentials{
+		Token: c.GlobalString(globalFlagToken),
+		Id:    c.GlobalInt64(globalFlagID),
 	}
 }
 
+// Returns the snapshot to read from or nil to read the latest values.
+func snapshot(c *cli.Context) *messages.Snapshot {
+	if !c.GlobalIsSet(globalFlagSnapshot) {
+		return nil
+	}
+	return &messages.Snapshot{Id: c.GlobalInt64(globalFlagSnapshot)}
+}
+
 func main() {
 	var rootContext = actor.EmptyRootContext
-	var wg sync.WaitGroup
 	var bindAddr, remoteAddr string
-	var pid, remotePid *actor.PID
+	var remotePid *actor.PID
+	printer := &responsePrinter{out: os.Stdout}
+	var trace *tracer
 
 	app := cli.NewApp()
 	app.Author = "Dimitri Krivoj"
@@ -99,41 +246,127 @@ func main() {
 			Name:        "bind",
 			Usage:       "address treecli should use",
 			Value:       "localhost:8091",
+			EnvVar:      "TREECLI_BIND",
 			Destination: &bindAddr,
 		},
 		cli.StringFlag{
 			Name:        "remote",
 			Usage:       "address of the treeservice",
 			Value:       "localhost:8090",
+			EnvVar:      "TREECLI_REMOTE",
 			Destination: &remoteAddr,
 		},
 		cli.Int64Flag{
-			Name:  globalFlagID,
-			Usage: "id of the tree you want to alter",
+			Name:   globalFlagID,
+			Usage:  "id of the tree you want to alter",
+			EnvVar: "TREECLI_ID",
+		},
+		cli.StringFlag{
+			Name:   globalFlagToken,
+			Usage:  "token to authorize your access for the specified tree",
+			EnvVar: "TREECLI_TOKEN",
+		},
+		cli.StringFlag{
+			Name:   globalFlagProfile,
+			Usage:  "profile of the config file providing defaults for bind, remote, id and token",
+			EnvVar: "TREECLI_PROFILE",
+		},
+		cli.StringFlag{
+			Name:   globalFlagConfig,
+			Usage:  "config file with the profiles, default: ~/.config/treecli/config.yaml",
+			EnvVar: "TREECLI_CONFIG",
+		},
+		cli.Int64Flag{
+			Name:  globalFlagSnapshot,
+			Usage: "id of the snapshot search, range and traverse should read from",
+		},
+		cli.StringFlag{
+			Name:        "output",
+			Usage:       "format of results and errors: text, json, yaml or csv",
+			Value:       "text",
+			Destination: &printer.format,
+		},
+		cli.DurationFlag{
+			Name:        "timeout",
+			Usage:       "time the treeservice has for each request before it answers with DeadlineExceededError",
+			Value:       timeout,
+			EnvVar:      "TREECLI_TIMEOUT",
+			Destination: &timeout,
+		},
+		cli.IntFlag{
+			Name:        "retries",
+			Usage:       "retries of the connection and of requests without response which are safe to repeat",
+			Value:       retries,
+			EnvVar:      "TREECLI_RETRIES",
+			Destination: &retries,
+		},
+		cli.DurationFlag{
+			Name:        "retry-backoff",
+			Usage:       "wait before the first retry, doubled for each further one up to " + maxRetryBackoff.String(),
+			Value:       retryBackoff,
+			Destination: &retryBackoff,
+		},
+		cli.StringFlag{
+			Name:   "tls-cert",
+			Usage:  "certificate of the treecli in PEM format, enables TLS for the remote traffic",
+			EnvVar: "TREECLI_TLS_CERT",
+		},
+		cli.StringFlag{
+			Name:   "tls-key",
+			Usage:  "private key of the certificate in PEM format",
+			EnvVar: "TREECLI_TLS_KEY",
 		},
 		cli.StringFlag{
-			Name:  globalFlagToken,
-			Usage: "token to authorize your access for the specified tree",
+			Name:   "tls-ca",
+			Usage:  "CA in PEM format verifying the certificates of peers, default: the system's CAs",
+			EnvVar: "TREECLI_TLS_CA",
+		},
+		cli.BoolFlag{
+			Name:   "tls-client-auth",
+			Usage:  "only accept connections from peers with a certificate signed by --tls-ca",
+			EnvVar: "TREECLI_TLS_CLIENT_AUTH",
+		},
+		cli.BoolFlag{
+			Name:  "trace",
+			Usage: "trace the requests and log the time they spent in the treeservice and each node they passed",
 		},
 	}
 
 	before := func(c *cli.Context) error {
-		remote.Start(bindAddr)
-		props := actor.PropsFromProducer(func() actor.Actor {
-			myActor := treeCliActor{wg: &wg}
-			return &myActor
-		})
-		pidResp, err := remote.SpawnNamed(
-			remoteAddr,
-			"remote",
-			"treeservice",
-			timeout,
-		)
-		if err == nil {
+		if err := printer.validate(); err != nil {
+			return exitError(err)
+		}
+		if err := applyProfile(c); err != nil {
+			return exitError(err)
+		}
+		middleware := []actor.SenderMiddleware{withDeadline, withIdempotencyKey}
+		if c.GlobalBool("trace") {
+			trace = &tracer{}
+			middleware = append(middleware, trace.middleware)
+		}
+		rootContext = actor.NewRootContext(nil, middleware...)
+		options, err := remotetls.Config{
+			CertFile:   c.GlobalString("tls-cert"),
+			KeyFile:    c.GlobalString("tls-key"),
+			CAFile:     c.GlobalString("tls-ca"),
+			ClientAuth: c.GlobalBool("tls-client-auth"),
+		}.Options()
+		if err != nil {
+			return exitError(err)
+		}
+		remote.Start(bindAddr, options...)
+		err = withRetries("connect to "+remoteAddr, func() (bool, error) {
+			pidResp, err := remote.SpawnNamed(remoteAddr, "remote", "treeservice", activationTimeout)
+			if err != nil {
+				return true, err
+			}
 			remotePid = pidResp.Pid
-			pid = rootContext.Spawn(props)
+			return false, nil
+		})
+		if err != nil {
+			return exitError(err)
 		}
-		return err
+		return nil
 	}
 
 	app.Commands = []cli.Command{
@@ -142,15 +375,75 @@ func main() {
 			Name:     "create",
 			Usage:    "create a new search tree",
 			Description: "Create a new search tree with the specified maximum size for its leafs (default 2). " +
-				"Outputs id and token of the created tree.",
+				"Outputs id and token of the created tree.\n" +
+				"   With --ttl or --idle-timeout the tree is deleted automatically.\n" +
+				"   --max-items, --max-value-bytes and --max-total-bytes set a quota, inserts exceeding it fail " +
+				"with QuotaExceededError.\n" +
+				"   With --save-as the credentials are stored as profile in the config file.",
 			ArgsUsage: "[maxSize=2]",
-			Before:    before,
-			Action: func(c *cli.Context) {
-				maxSize, err := strconv.ParseInt(c.Args().First(), 10, 64)
+			Flags: []cli.Flag{
+				cli.DurationFlag{
+					Name:  "ttl",
+					Usage: "time after which the tree is deleted, e.g. 24h",
+				},
+				cli.DurationFlag{
+					Name:  "idle-timeout",
+					Usage: "the tree is deleted if it isn't accessed for this duration",
+				},
+				cli.Int64Flag{
+					Name:  "max-items",
+					Usage: "maximum number of items in the tree, 0 for no limit besides that of all trees",
+				},
+				cli.Int64Flag{
+					Name:  "max-value-bytes",
+					Usage: "maximum length of a value in bytes, 0 for the limit of the treeservice",
+				},
+				cli.Int64Flag{
+					Name:  "max-total-bytes",
+					Usage: "maximum total length of all values in bytes, 0 for no limit besides that of all trees",
+				},
+				cli.StringFlag{
+					Name:  "save-as",
+					Usage: "name of the profile the credentials are stored as",
+				},
+			},
+			Before: before,
+			Action: func(c *cli.Context) error {
+				maxSize := int64(2)
+				if c.NArg() > 0 {
+					var err error
+					if maxSize, err = intArg(c, 0, "maxSize"); err != nil {
+						return err
+					}
+				}
+				res, err := request(rootContext, remotePid, &messages.CreateTreeRequest{
+					MaxSize:     maxSize,
+					Ttl:         int64(c.Duration("ttl") / time.Millisecond),
+					IdleTimeout: int64(c.Duration("idle-timeout") / time.Millisecond),
+					Quota: &messages.Quota{
+						MaxItems:      c.Int64("max-items"),
+						MaxValueBytes: c.Int64("max-value-bytes"),
+						MaxTotalBytes: c.Int64("max-total-bytes"),
+					},
+				})
 				if err != nil {
-					maxSize = 2
+					return exitError(err)
+				}
+				printer.print(res)
+				created, ok := res.(*messages.CreateTreeResponse)
+				if ok {
+					trace.created(created.Credentials)
 				}
-				requestAndWait(rootContext, &wg, remotePid, pid, &messages.CreateTreeRequest{MaxSize: maxSize})
+				if name := c.String("save-as"); name != "" {
+					if !ok {
+						return exitError(unexpectedResponse(res))
+					}
+					if err := saveProfile(c, name, created.Credentials); err != nil {
+						return exitError(err)
+					}
+					log.Printf("Saved credentials as profile %s", name)
+				}
+				return nil
 			},
 		},
 		{
@@ -160,17 +453,45 @@ func main() {
 			Description: "Inserts new key-value pair into specified tree. Outputs key-value pair on success. \n" +
 				"   Fails if the specified tree doesn't exist or if an invalid token is provided.\n" +
 				"   Also fails if the specified key already exists. " +
-				"In this case the existing key-value pair will be printed.",
+				"In this case the existing key-value pair will be printed.\n" +
+				"   With --ttl or --expires-at the pair is deleted automatically once it expired.",
 			ArgsUsage: "key value",
-			Before:    before,
-			Action: func(c *cli.Context) {
-				key, err := strconv.ParseInt(c.Args().First(), 10, 64)
+			Flags: []cli.Flag{
+				cli.DurationFlag{
+					Name:  "ttl",
+					Usage: "time after which the pair expires, e.g. 30m",
+				},
+				cli.StringFlag{
+					Name:  "expires-at",
+					Usage: "point in time at which the pair expires in RFC 3339 format",
+				},
+				cli.StringFlag{
+					Name:  "idempotency-key",
+					Usage: "key identifying the insert, repeating it with the same key returns the first response",
+				},
+			},
+			Before: before,
+			Action: func(c *cli.Context) error {
+				key, err := intArg(c, 0, "key")
 				if err != nil {
-					panic(err)
+					return err
+				}
+				if len(c.Args()) < 2 {
+					return usageError(c, "value missing")
+				}
+				value := c.Args().Get(1)
+				var expiresAt int64
+				if c.IsSet("expires-at") {
+					at, err := time.Parse(time.RFC3339, c.String("expires-at"))
+					if err != nil {
+						return usageError(c, "--expires-at must be in RFC 3339 format, got %q", c.String("expires-at"))
+					}
+					expiresAt = at.UnixNano() / int64(time.Millisecond)
+				}
+				if err := checkCredentials(c); err != nil {
+					return err
 				}
-				value := c.Args().Tail()[0]
-				assertCredentialsExist(c)
-				requestAndWait(rootContext, &wg, remotePid, pid, &messages.InsertRequest{
+				requestAndWait(rootContext, remotePid, printer, &messages.InsertRequest{
 					Credentials: &messages.Credentials{
 						Token: c.GlobalString(globalFlagToken),
 						Id:    c.GlobalInt64(globalFlagID),
@@ -179,7 +500,11 @@ func main() {
 						Key:   key,
 						Value: value,
 					},
+					Ttl:            int64(c.Duration("ttl") / time.Millisecond),
+					ExpiresAt:      expiresAt,
+					IdempotencyKey: c.String("idempotency-key"),
 				})
+				return nil
 			},
 		},
 		{
@@ -191,19 +516,23 @@ func main() {
 				"   Fails if the specified tree doesn't exist or if an invalid token is provided.\n" +
 				"   Also fails if the specified key doesn't exist. ",
 			Before: before,
-			Action: func(c *cli.Context) {
-				key, err := strconv.ParseInt(c.Args().First(), 10, 64)
+			Action: func(c *cli.Context) error {
+				key, err := intArg(c, 0, "key")
 				if err != nil {
-					panic(err)
+					return err
 				}
-				assertCredentialsExist(c)
-				requestAndWait(rootContext, &wg, remotePid, pid, &messages.SearchRequest{
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.SearchRequest{
 					Credentials: &messages.Credentials{
 						Token: c.GlobalString(globalFlagToken),
 						Id:    c.GlobalInt64(globalFlagID),
 					},
-					Key: key,
+					Key:      key,
+					Snapshot: snapshot(c),
 				})
+				return nil
 			},
 		},
 		{
@@ -215,20 +544,30 @@ func main() {
 				"Outputs deleted key-value pair on success. \n" +
 				"   Fails if the specified tree doesn't exist or if an invalid token is provided.\n" +
 				"   Also fails if the specified key doesn't exist. ",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "idempotency-key",
+					Usage: "key identifying the delete, repeating it with the same key returns the first response",
+				},
+			},
 			Before: before,
-			Action: func(c *cli.Context) {
-				key, err := strconv.ParseInt(c.Args().First(), 10, 64)
+			Action: func(c *cli.Context) error {
+				key, err := intArg(c, 0, "key")
 				if err != nil {
-					panic(err)
+					return err
+				}
+				if err := checkCredentials(c); err != nil {
+					return err
 				}
-				assertCredentialsExist(c)
-				requestAndWait(rootContext, &wg, remotePid, pid, &messages.DeleteRequest{
+				requestAndWait(rootContext, remotePid, printer, &messages.DeleteRequest{
 					Credentials: &messages.Credentials{
 						Token: c.GlobalString(globalFlagToken),
 						Id:    c.GlobalInt64(globalFlagID),
 					},
-					Key: key,
+					Key:            key,
+					IdempotencyKey: c.String("idempotency-key"),
 				})
+				return nil
 			},
 		},
 		{
@@ -238,14 +577,88 @@ func main() {
 			Description: "Gets all key-value pairs in specified tree sorted by keys. \n" +
 				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
 			Before: before,
-			Action: func(c *cli.Context) {
-				assertCredentialsExist(c)
-				requestAndWait(rootContext, &wg, remotePid, pid, &messages.TraverseRequest{
+			Action: func(c *cli.Context) error {
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.TraverseRequest{
 					Credentials: &messages.Credentials{
 						Token: c.GlobalString(globalFlagToken),
 						Id:    c.GlobalInt64(globalFlagID),
 					},
+					Snapshot: snapshot(c),
+				})
+				return nil
+			},
+		},
+		{
+			HelpName:  "range",
+			Name:      "range",
+			ArgsUsage: "from to",
+			Usage:     "get key-value pairs with from <= key <= to sorted by key",
+			Description: "Gets all key-value pairs in specified tree with keys between from and to sorted by keys. \n" +
+				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
+			Before: before,
+			Action: func(c *cli.Context) error {
+				from, err := intArg(c, 0, "from")
+				if err != nil {
+					return err
+				}
+				to, err := intArg(c, 1, "to")
+				if err != nil {
+					return err
+				}
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.RangeRequest{
+					Credentials: credentials(c),
+					From:        from,
+					To:          to,
+					Snapshot:    snapshot(c),
+				})
+				return nil
+			},
+		},
+		{
+			HelpName: "snapshot",
+			Name:     "snapshot",
+			Usage:    "take a snapshot of the tree",
+			Description: "Takes a consistent snapshot of the specified tree. Outputs the id of the snapshot.\n" +
+				"   Pass it with --snapshot to search, range or traverse to read the tree as it was.\n" +
+				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
+			Before: before,
+			Action: func(c *cli.Context) error {
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.SnapshotRequest{
+					Credentials: credentials(c),
 				})
+				return nil
+			},
+		},
+		{
+			HelpName:  "releasesnapshot",
+			Name:      "releasesnapshot",
+			ArgsUsage: "snapshotId",
+			Usage:     "release a snapshot of the tree",
+			Description: "Releases the snapshot so the tree can drop the versions only visible in it.\n" +
+				"   Fails if the specified tree or snapshot doesn't exist or if an invalid token is provided.",
+			Before: before,
+			Action: func(c *cli.Context) error {
+				snapshotID, err := intArg(c, 0, "snapshotId")
+				if err != nil {
+					return err
+				}
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.ReleaseSnapshotRequest{
+					Credentials: credentials(c),
+					SnapshotId:  snapshotID,
+				})
+				return nil
 			},
 		},
 		{
@@ -255,23 +668,418 @@ func main() {
 			Description: "Removes specified tree. Asks for confirmation by repeating the token.\n" +
 				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
 			Before: before,
-			Action: func(c *cli.Context) {
-				assertCredentialsExist(c)
-				fmt.Printf("Repeat token to delete tree %d: ", c.GlobalInt64(globalFlagID))
+			Action: func(c *cli.Context) error {
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "Repeat token to delete tree %d: ", c.GlobalInt64(globalFlagID))
 				var token string
 				n, err := fmt.Scanf("%s", &token)
 				if n != 1 || err != nil || c.GlobalString(globalFlagToken) != token {
-					fmt.Printf("Token doesn't match flag - tree %d remains", c.GlobalInt64(globalFlagID))
-					return
+					return exitError(fmt.Errorf("token doesn't match flag - tree %d remains", c.GlobalInt64(globalFlagID)))
 				}
-				requestAndWait(rootContext, &wg, remotePid, pid, &messages.DeleteTreeRequest{
+				requestAndWait(rootContext, remotePid, printer, &messages.DeleteTreeRequest{
 					Credentials: &messages.Credentials{
 						Token: c.GlobalString(globalFlagToken),
 						Id:    c.GlobalInt64(globalFlagID),
 					},
 				})
+				return nil
+			},
+		},
+		{
+			HelpName: "info",
+			Name:     "info",
+			Usage:    "show settings of tree",
+			Description: "Outputs the maximum leaf size, expiry and idle timeout of the specified tree.\n" +
+				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
+			Before: before,
+			Action: func(c *cli.Context) error {
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.TreeInfoRequest{
+					Credentials: credentials(c),
+				})
+				return nil
+			},
+		},
+		{
+			HelpName: "export",
+			Name:     "export",
+			Usage:    "write all key-value pairs of tree to stdout",
+			Description: "Writes maximum leaf size and all key-value pairs of the specified tree as they are " +
+				"in a snapshot to stdout.\n" +
+				"   Formats are jsonl, csv and protobuf (length delimited messages).\n" +
+				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "jsonl, csv or protobuf",
+					Value: "jsonl",
+				},
+			},
+			Before: before,
+			Action: func(c *cli.Context) error {
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				writer, err := newExportWriter(c.String("format"), os.Stdout)
+				if err != nil {
+					return exitError(err)
+				}
+				if err := exportTree(rootContext, remotePid, credentials(c), writer); err != nil {
+					return exitError(err)
+				}
+				return nil
+			},
+		},
+		{
+			HelpName:  "import",
+			Name:      "import",
+			ArgsUsage: "file",
+			Usage:     "create tree from exported key-value pairs",
+			Description: "Creates a new tree with the maximum leaf size of the exported tree and inserts its " +
+				"key-value pairs. Outputs id and token of the new tree.\n" +
+				"   The format is taken from the file extension (.csv, .pb) unless --format is given.\n" +
+				"   The progress is saved in file.import. Running the import again resumes an interrupted import.",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "jsonl, csv or protobuf",
+				},
+			},
+			Before: before,
+			Action: func(c *cli.Context) error {
+				path := c.Args().First()
+				format := c.String("format")
+				if format == "" {
+					format = formatOf(path)
+				}
+				imported, err := importTree(rootContext, remotePid, path, format)
+				if err != nil {
+					return exitError(err)
+				}
+				printer.print(&messages.CreateTreeResponse{Credentials: imported})
+				return nil
+			},
+		},
+		{
+			HelpName: "clone",
+			Name:     "clone",
+			Usage:    "copy tree into a new tree",
+			Description: "Creates a new tree with the same maximum leaf size and the same key-value pairs " +
+				"as the specified tree. Outputs id and token of the new tree.\n" +
+				"   Both trees can be changed independently afterwards.\n" +
+				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
+			Flags: []cli.Flag{
+				cli.DurationFlag{
+					Name:  "ttl",
+					Usage: "time after which the new tree is deleted, e.g. 24h",
+				},
+				cli.DurationFlag{
+					Name:  "idle-timeout",
+					Usage: "the new tree is deleted if it isn't accessed for this duration",
+				},
+			},
+			Before: before,
+			Action: func(c *cli.Context) error {
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.CloneTreeRequest{
+					Credentials: credentials(c),
+					Ttl:         int64(c.Duration("ttl") / time.Millisecond),
+					IdleTimeout: int64(c.Duration("idle-timeout") / time.Millisecond),
+				})
+				return nil
+			},
+		},
+		{
+			HelpName: "setexpiry",
+			Name:     "setexpiry",
+			Usage:    "change when the tree is deleted automatically",
+			Description: "Sets a new ttl counting from now and/or a new idle timeout for the specified tree. " +
+				"Outputs the resulting limits.\n" +
+				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
+			Flags: []cli.Flag{
+				cli.DurationFlag{
+					Name:  "ttl",
+					Usage: "time after which the tree is deleted, e.g. 24h",
+				},
+				cli.DurationFlag{
+					Name:  "idle-timeout",
+					Usage: "the tree is deleted if it isn't accessed for this duration",
+				},
+				cli.BoolFlag{
+					Name:  "no-ttl",
+					Usage: "remove the ttl",
+				},
+				cli.BoolFlag{
+					Name:  "no-idle-timeout",
+					Usage: "remove the idle timeout",
+				},
+			},
+			Before: before,
+			Action: func(c *cli.Context) error {
+				if err := checkCredentials(c); err != nil {
+					return err
+				}
+				ttl := int64(c.Duration("ttl") / time.Millisecond)
+				if c.Bool("no-ttl") {
+					ttl = -1
+				}
+				idleTimeout := int64(c.Duration("idle-timeout") / time.Millisecond)
+				if c.Bool("no-idle-timeout") {
+					idleTimeout = -1
+				}
+				requestAndWait(rootContext, remotePid, printer, &messages.SetTreeExpiryRequest{
+					Credentials: credentials(c),
+					Ttl:         ttl,
+					IdleTimeout: idleTimeout,
+				})
+				return nil
+			},
+		},
+		{
+			HelpName: "bench",
+			Name:     "bench",
+			Usage:    "measure throughput and latency of the treeservice",
+			Description: "Creates a tree for each of the given maximum leaf sizes and prefills it. " +
+				"Then concurrent clients send requests\n" +
+				"   in the given mix for the given duration. Outputs ops/s and latency percentiles per operation " +
+				"and compares the maxSize settings.\n" +
+				"   The trees are deleted afterwards.",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "clients",
+					Usage: "number of concurrent clients",
+					Value: 10,
+				},
+				cli.DurationFlag{
+					Name:  "duration",
+					Usage: "duration of each run",
+					Value: 10 * time.Second,
+				},
+				cli.StringFlag{
+					Name:  "mix",
+					Usage: "weights of the operations insert, search, delete and traverse",
+					Value: "insert=30,search=50,delete=15,traverse=5",
+				},
+				cli.Int64Flag{
+					Name:  "keys",
+					Usage: "keys are chosen from 0 to keys-1",
+					Value: 10000,
+				},
+				cli.StringFlag{
+					Name:  "distribution",
+					Usage: "distribution of the keys: uniform, zipfian or sequential",
+					Value: "uniform",
+				},
+				cli.Int64Flag{
+					Name:  "prefill",
+					Usage: "number of items inserted before each run",
+					Value: 1000,
+				},
+				cli.StringFlag{
+					Name:  "max-sizes",
+					Usage: "comma separated maximum leaf sizes to compare",
+					Value: "2",
+				},
+			},
+			Before: before,
+			Action: func(c *cli.Context) error {
+				mix, err := parseMix(c.String("mix"))
+				if err != nil {
+					return exitError(err)
+				}
+				if c.Int("clients") < 1 || c.Int64("keys") < 2 {
+					return exitError(fmt.Errorf("at least one client and two keys are required"))
+				}
+				maxSizes := make([]int64, 0)
+				for _, value := range strings.Split(c.String("max-sizes"), ",") {
+					maxSize, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+					if err != nil {
+						return exitError(err)
+					}
+					maxSizes = append(maxSizes, maxSize)
+				}
+				config := &benchConfig{
+					clients:      c.Int("clients"),
+					duration:     c.Duration("duration"),
+					keys:         c.Int64("keys"),
+					distribution: c.String("distribution"),
+					mix:          mix,
+					prefill:      c.Int64("prefill"),
+				}
+				if err := runBench(rootContext, remotePid, config, maxSizes); err != nil {
+					return exitError(err)
+				}
+				return nil
+			},
+		},
+		{
+			HelpName:  "run",
+			Name:      "run",
+			ArgsUsage: "file.jsonl",
+			Usage:     "execute requests from a JSON lines file",
+			Description: "Executes one request per line, e.g. " +
+				"{\"request\": \"search\", \"key\": 2, \"expect\": {\"value\": \"zwei\"}}, and outputs the responses.\n" +
+				"   Requests: create (maxSize, as), insert (key, value), search (key), delete (key), traverse, " +
+				"range (from, to), info, deletetree.\n" +
+				"   Requests use the last created tree unless id and token are given. " +
+				"These and value may contain ${id} and ${token}\n" +
+				"   of the last created tree or ${name.id} and ${name.token} of the tree created with \"as\": \"name\".\n" +
+				"   Stops at the first response not matching the fields given in expect " +
+				"(a list for all results of traverse and range).",
+			Before: before,
+			Action: func(c *cli.Context) error {
+				if err := runScript(rootContext, remotePid, c.Args().First(), printer); err != nil {
+					return exitError(err)
+				}
+				// Error responses of the script were either expected or not checked
+				printer.exitCode = exitOK
+				return nil
+			},
+		},
+		{
+			HelpName: "shell",
+			Name:     "shell",
+			Usage:    "run commands interactively",
+			Description: "Starts an interactive shell sending all commands over one connection to the treeservice.\n" +
+				"   Uses the tree specified by --id and --token until another one is selected with use or create.\n" +
+				"   Type help in the shell for a list of commands.",
+			Before: before,
+			Action: func(c *cli.Context) error {
+				var current *messages.Credentials
+				if c.GlobalIsSet(globalFlagID) && c.GlobalIsSet(globalFlagToken) {
+					current = credentials(c)
+				}
+				log.SetFlags(0)
+				log.SetOutput(os.Stdout)
+				if err := runShell(rootContext, remotePid, current, printer, trace); err != nil {
+					return exitError(err)
+				}
+				// Errors inside the shell don't determine the exit code
+				printer.exitCode = exitOK
+				return nil
 			},
 		},
+		{
+			HelpName: "tx",
+			Name:     "tx",
+			Usage:    "modify several keys atomically",
+			Description: "Stages inserts, updates and deletes in a transaction which is applied atomically on commit.\n" +
+				"   Fails if the specified tree doesn't exist or if an invalid token is provided.",
+			Subcommands: []cli.Command{
+				{
+					Name:        "begin",
+					Usage:       "begin a new transaction",
+					Description: "Begins a new transaction on the specified tree. Outputs the id of the transaction.",
+					Before:      before,
+					Action: func(c *cli.Context) error {
+						if err := checkCredentials(c); err != nil {
+							return err
+						}
+						requestAndWait(rootContext, remotePid, printer, &messages.BeginTxRequest{
+							Credentials: credentials(c),
+						})
+						return nil
+					},
+				},
+				{
+					Name:      "insert",
+					Usage:     "stage insert of key-value pair",
+					ArgsUsage: "txId key value",
+					Description: "Stages insert of a new key-value pair. " +
+						"The commit fails if the key exists by then.",
+					Before: before,
+					Action: func(c *cli.Context) error {
+						return stageAndWait(c, rootContext, remotePid, printer, messages.INSERT)
+					},
+				},
+				{
+					Name:      "update",
+					Usage:     "stage update of key-value pair",
+					ArgsUsage: "txId key value",
+					Description: "Stages replacing the value of an existing key. " +
+						"The commit fails if the key doesn't exist by then.",
+					Before: before,
+					Action: func(c *cli.Context) error {
+						return stageAndWait(c, rootContext, remotePid, printer, messages.UPDATE)
+					},
+				},
+				{
+					Name:      "delete",
+					Usage:     "stage deletion of key-value pair",
+					ArgsUsage: "txId key",
+					Description: "Stages deletion of an existing key. " +
+						"The commit fails if the key doesn't exist by then.",
+					Before: before,
+					Action: func(c *cli.Context) error {
+						return stageAndWait(c, rootContext, remotePid, printer, messages.DELETE)
+					},
+				},
+				{
+					Name:      "commit",
+					Usage:     "apply all staged operations atomically",
+					ArgsUsage: "txId",
+					Description: "Applies all staged operations or none of them. " +
+						"Outputs the reason if the transaction was aborted.",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "idempotency-key",
+							Usage: "key identifying the commit, repeating it with the same key returns the first response",
+						},
+					},
+					Before: before,
+					Action: func(c *cli.Context) error {
+						if err := checkCredentials(c); err != nil {
+							return err
+						}
+						txID, err := intArg(c, 0, "txId")
+						if err != nil {
+							return err
+						}
+						requestAndWait(rootContext, remotePid, printer, &messages.CommitTxRequest{
+							Credentials:    credentials(c),
+							TxId:           txID,
+							IdempotencyKey: c.String("idempotency-key"),
+						})
+						return nil
+					},
+				},
+				{
+					Name:        "abort",
+					Usage:       "discard all staged operations",
+					ArgsUsage:   "txId",
+					Description: "Discards the transaction without applying any of its operations.",
+					Before:      before,
+					Action: func(c *cli.Context) error {
+						if err := checkCredentials(c); err != nil {
+							return err
+						}
+						txID, err := intArg(c, 0, "txId")
+						if err != nil {
+							return err
+						}
+						requestAndWait(rootContext, remotePid, printer, &messages.AbortTxRequest{
+							Credentials: credentials(c),
+							TxId:        txID,
+						})
+						return nil
+					},
+				},
+			},
+		},
+	}
+	// Runs after every command, so traces are printed once the responses are
+	app.After = func(c *cli.Context) error {
+		if trace != nil && remotePid != nil {
+			trace.printTraces(rootContext, remotePid)
+		}
+		return nil
 	}
 	_ = app.Run(os.Args)
+	os.Exit(printer.exitCode)
 }
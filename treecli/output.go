@@ -0,0 +1,347 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// Exit codes of treecli. Each error of the treeservice has its own exit code.
+// exitAssertionFailed is used if a response of a script doesn't match its expectation,
+// exitUsage if the arguments of a command are invalid.
+// 2 is left out because the go runtime uses it on panics.
+const (
+	exitOK               = 0
+	exitFailure          = 1
+	exitNoSuchTree       = 3
+	exitInvalidToken     = 4
+	exitNoSuchKey        = 5
+	exitKeyAlreadyExists = 6
+	exitNoSuchSnapshot   = 7
+	exitNoSuchTx         = 8
+	exitKeyLocked        = 9
+	exitTxAborted        = 10
+	exitAssertionFailed  = 11
+	exitDeadlineExceeded = 12
+	exitQuotaExceeded    = 13
+	exitRateLimited      = 14
+	exitOverloaded       = 15
+	exitShuttingDown     = 16
+	exitInvalidRequest   = 17
+	exitUsage            = 18
+)
+
+var outputFormats = []string{"text", "json", "yaml", "csv"}
+
+// Structured form of a response: the names of its fields and one row of values per result.
+type table struct {
+	columns []string
+	rows    [][]interface{}
+}
+
+var itemColumns = []string{"key", "value", "expiresAt"}
+
+func itemTable(items ...*messages.Item) *table {
+	response := &table{columns: itemColumns, rows: make([][]interface{}, 0, len(items))}
+	for _, item := range items {
+		response.rows = append(response.rows, []interface{}{item.Key, item.Value, item.ExpiresAt})
+	}
+	return response
+}
+
+// Returns a table with a single row whose first column names the error.
+func errorTable(name string, columns []string, values ...interface{}) *table {
+	return &table{
+		columns: append([]string{"error"}, columns...),
+		rows:    [][]interface{}{append([]interface{}{name}, values...)},
+	}
+}
+
+// Returns the structured form of a response and the exit code treecli should end with.
+// Returns nil if the message is no response.
+func tableOf(message interface{}) (*table, int) {
+	switch msg := message.(type) {
+	case *messages.NoSuchTreeError:
+		return errorTable("NoSuchTreeError", []string{"id"}, msg.Id), exitNoSuchTree
+	case *messages.NoSuchKeyError:
+		return errorTable("NoSuchKeyError", []string{"key"}, msg.Key), exitNoSuchKey
+	case *messages.InvalidTokenError:
+		return errorTable("InvalidTokenError", []string{"id", "token"},
+			msg.Credentials.Id,
+			msg.Credentials.Token,
+		), exitInvalidToken
+	case *messages.KeyAlreadyExistsError:
+		return errorTable("KeyAlreadyExistsError", itemColumns,
+			msg.Item.Key,
+			msg.Item.Value,
+			msg.Item.ExpiresAt,
+		), exitKeyAlreadyExists
+	case *messages.NoSuchSnapshotError:
+		return errorTable("NoSuchSnapshotError", []string{"snapshotId"}, msg.SnapshotId), exitNoSuchSnapshot
+	case *messages.KeyLockedError:
+		return errorTable("KeyLockedError", []string{"key", "txId"}, msg.Key, msg.TxId), exitKeyLocked
+	case *messages.NoSuchTxError:
+		return errorTable("NoSuchTxError", []string{"txId"}, msg.TxId), exitNoSuchTx
+	case *messages.TxAbortedError:
+		return errorTable("TxAbortedError", []string{"txId", "reason"}, msg.TxId, msg.Reason), exitTxAborted
+	case *messages.DeadlineExceededError:
+		return errorTable("DeadlineExceededError", []string{"deadline"}, msg.Deadline), exitDeadlineExceeded
+	case *messages.QuotaExceededError:
+		return errorTable("QuotaExceededError", []string{"scope", "limit", "max", "requested"},
+			msg.Scope, msg.Limit, msg.Max, msg.Requested,
+		), exitQuotaExceeded
+	case *messages.RateLimitedError:
+		return errorTable("RateLimitedError", []string{"scope", "key", "retryAfter"},
+			msg.Scope, msg.Key, msg.RetryAfter,
+		), exitRateLimited
+	case *messages.OverloadedError:
+		return errorTable("OverloadedError", []string{"node", "capacity"}, msg.Node, msg.Capacity), exitOverloaded
+	case *messages.ShuttingDownError:
+		return errorTable("ShuttingDownError", nil), exitShuttingDown
+	case *messages.InvalidRequestError:
+		return errorTable("InvalidRequestError", []string{"reason"}, msg.Reason), exitInvalidRequest
+	case *messages.CreateTreeResponse:
+		return &table{
+			columns: []string{"id", "token"},
+			rows:    [][]interface{}{{msg.Credentials.Id, msg.Credentials.Token}},
+		}, exitOK
+	case *messages.InsertResponse:
+		return itemTable(msg.Item), exitOK
+	case *messages.SearchResponse:
+		return itemTable(msg.Item), exitOK
+	case *messages.DeleteResponse:
+		return itemTable(msg.Item), exitOK
+	case *messages.TraverseResponse:
+		return itemTable(msg.Items...), exitOK
+	case *messages.RangeResponse:
+		return itemTable(msg.Items...), exitOK
+	case *messages.SnapshotResponse:
+		return &table{columns: []string{"snapshotId"}, rows: [][]interface{}{{msg.SnapshotId}}}, exitOK
+	case *messages.ReleaseSnapshotResponse:
+		return &table{columns: []string{"snapshotId"}, rows: [][]interface{}{{msg.SnapshotId}}}, exitOK
+	case *messages.DeleteTreeResponse:
+		return &table{columns: []string{"id"}, rows: [][]interface{}{{msg.Credentials.Id}}}, exitOK
+	case *messages.TreeInfoResponse:
+		return &table{
+			columns: []string{
+				"id", "maxSize", "expiresAt", "idleTimeout", "items", "bytes",
+				"maxItems", "maxValueBytes", "maxTotalBytes",
+			},
+			rows: [][]interface{}{{
+				msg.Credentials.Id,
+				msg.MaxSize,
+				msg.ExpiresAt,
+				msg.IdleTimeout,
+				msg.Items,
+				msg.Bytes,
+				msg.Quota.GetMaxItems(),
+				msg.Quota.GetMaxValueBytes(),
+				msg.Quota.GetMaxTotalBytes(),
+			}},
+		}, exitOK
+	case *messages.CloneTreeResponse:
+		return &table{
+			columns: []string{"source", "id", "token", "itemCount"},
+			rows: [][]interface{}{{
+				msg.Source.Id,
+				msg.Credentials.Id,
+				msg.Credentials.Token,
+				msg.ItemCount,
+			}},
+		}, exitOK
+	case *messages.SetTreeExpiryResponse:
+		return &table{
+			columns: []string{"id", "expiresAt", "idleTimeout"},
+			rows:    [][]interface{}{{msg.Credentials.Id, msg.ExpiresAt, msg.IdleTimeout}},
+		}, exitOK
+	case *messages.BeginTxResponse:
+		return &table{columns: []string{"txId", "id"}, rows: [][]interface{}{{msg.TxId, msg.Credentials.Id}}}, exitOK
+	case *messages.StageTxResponse:
+		return &table{
+			columns: []string{"txId", "type", "key", "value"},
+			rows: [][]interface{}{{
+				msg.TxId,
+				msg.Operation.Type.String(),
+				msg.Operation.Item.Key,
+				msg.Operation.Item.Value,
+			}},
+		}, exitOK
+	case *messages.CommitTxResponse:
+		response := &table{columns: []string{"txId", "type", "key", "value"}}
+		for _, operation := range msg.Operations {
+			response.rows = append(response.rows, []interface{}{
+				msg.TxId,
+				operation.Type.String(),
+				operation.Item.Key,
+				operation.Item.Value,
+			})
+		}
+		return response, exitOK
+	case *messages.AbortTxResponse:
+		return &table{columns: []string{"txId"}, rows: [][]interface{}{{msg.TxId}}}, exitOK
+	}
+	return nil, exitOK
+}
+
+// Prints responses of the treeservice in the selected output format.
+// Remembers the exit code belonging to the last response.
+type responsePrinter struct {
+	format   string
+	out      io.Writer
+	exitCode int
+}
+
+func (printer *responsePrinter) validate() error {
+	for _, format := range outputFormats {
+		if printer.format == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown output format %s, expected one of %v", printer.format, outputFormats)
+}
+
+// Prints a response. Returns false if the message is no response.
+func (printer *responsePrinter) print(message interface{}) bool {
+	response, exitCode := tableOf(message)
+	if response == nil {
+		return false
+	}
+	printer.exitCode = exitCode
+	printer.write(response, func() {
+		printResponse(message)
+	})
+	return true
+}
+
+// Prints that a request got no response as error NoResponse with the reason. Remembers exitDeadlineExceeded
+// if treecli gave up waiting for it and exitFailure otherwise.
+func (printer *responsePrinter) printNoResponse(err error) {
+	printer.exitCode = exitFailure
+	if err == actor.ErrTimeout {
+		printer.exitCode = exitDeadlineExceeded
+	}
+	printer.write(errorTable("NoResponse", []string{"reason"}, err.Error()), func() {
+		log.Printf("No response from the treeservice: %v", err)
+	})
+}
+
+// Writes the table in the selected output format or calls text for the text format.
+func (printer *responsePrinter) write(response *table, text func()) {
+	var err error
+	switch printer.format {
+	case "json":
+		err = writeJSON(printer.out, response)
+	case "yaml":
+		err = writeYAML(printer.out, response)
+	case "csv":
+		err = writeCSV(printer.out, response)
+	default:
+		text()
+	}
+	if err != nil {
+		log.Printf("Failed to write output: %v", err)
+	}
+}
+
+// Writes one JSON object per row. The fields keep the order of the columns.
+func writeJSON(w io.Writer, response *table) error {
+	for _, row := range response.rows {
+		var buffer bytes.Buffer
+		buffer.WriteByte('{')
+		for i, column := range response.columns {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			name, _ := json.Marshal(column)
+			value, err := json.Marshal(row[i])
+			if err != nil {
+				return err
+			}
+			buffer.Write(name)
+			buffer.WriteByte(':')
+			buffer.Write(value)
+		}
+		buffer.WriteString("}\n")
+		if _, err := w.Write(buffer.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes the rows as YAML sequence of mappings.
+func writeYAML(w io.Writer, response *table) error {
+	rows := make([]yaml.MapSlice, 0, len(response.rows))
+	for _, row := range response.rows {
+		mapping := make(yaml.MapSlice, 0, len(response.columns))
+		for i, column := range response.columns {
+			mapping = append(mapping, yaml.MapItem{Key: column, Value: row[i]})
+		}
+		rows = append(rows, mapping)
+	}
+	data, err := yaml.Marshal(rows)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Writes a record with the column names followed by one record per row.
+func writeCSV(w io.Writer, response *table) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(response.columns); err != nil {
+		return err
+	}
+	for _, row := range response.rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			record[i] = fmt.Sprint(value)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Error response of the treeservice returned as error. Implements cli.ExitCoder.
+type responseError struct {
+	message  string
+	exitCode int
+}
+
+func (err *responseError) Error() string {
+	return err.message
+}
+
+func (err *responseError) ExitCode() int {
+	return err.exitCode
+}
+
+// Wraps err for returning it from an action so treecli ends with the matching exit code.
+func exitError(err error) error {
+	if exitCoder, ok := err.(cli.ExitCoder); ok {
+		return exitCoder
+	}
+	return cli.NewExitError(err, exitFailure)
+}
+
+// Returns the error for invalid arguments of the command, which ends treecli with exitUsage after showing the
+// usage of the command.
+func usageError(c *cli.Context, format string, args ...interface{}) error {
+	// Subcommands have the full path as HelpName, commands only their name
+	name := "treecli " + strings.TrimPrefix(c.Command.HelpName, "treecli ")
+	usage := strings.TrimSpace(name + " " + c.Command.ArgsUsage)
+	return cli.NewExitError(fmt.Sprintf(format+"\nUsage: %s", append(args, usage)...), exitUsage)
+}
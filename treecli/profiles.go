@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+const globalFlagProfile = "profile"
+const globalFlagConfig = "config"
+
+// Named settings so they don't have to be passed on every command.
+type profile struct {
+	Remote string `yaml:"remote,omitempty"`
+	Bind   string `yaml:"bind,omitempty"`
+	ID     int64  `yaml:"id,omitempty"`
+	Token  string `yaml:"token,omitempty"`
+}
+
+type config struct {
+	Profiles map[string]*profile `yaml:"profiles"`
+}
+
+// Returns the config file given by --config or else $XDG_CONFIG_HOME/treecli/config.yaml
+// or ~/.config/treecli/config.yaml.
+func configPath(c *cli.Context) string {
+	if path := c.GlobalString(globalFlagConfig); path != "" {
+		return path
+	}
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "treecli", "config.yaml")
+}
+
+// Reads the config file. A missing file results in a config without profiles.
+func loadConfig(path string) (*config, error) {
+	loaded := &config{Profiles: make(map[string]*profile)}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return loaded, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, loaded); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	if loaded.Profiles == nil {
+		loaded.Profiles = make(map[string]*profile)
+	}
+	return loaded, nil
+}
+
+// Writes the config file. Only the user may read it because it contains tokens.
+func saveConfig(path string, saved *config) error {
+	data, err := yaml.Marshal(saved)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+// Uses the values of the selected profile for all global flags which are neither given
+// on the command line nor by environment variable.
+func applyProfile(c *cli.Context) error {
+	name := c.GlobalString(globalFlagProfile)
+	if name == "" {
+		return nil
+	}
+	path := configPath(c)
+	loaded, err := loadConfig(path)
+	if err != nil {
+		return err
+	}
+	selected, exists := loaded.Profiles[name]
+	if !exists {
+		return fmt.Errorf("no profile %s in %s", name, path)
+	}
+	values := map[string]string{
+		"remote":        selected.Remote,
+		"bind":          selected.Bind,
+		globalFlagToken: selected.Token,
+	}
+	if selected.ID != 0 {
+		values[globalFlagID] = strconv.FormatInt(selected.ID, 10)
+	}
+	for flag, value := range values {
+		if value == "" || c.GlobalIsSet(flag) {
+			continue
+		}
+		if err := c.GlobalSet(flag, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stores the credentials of a tree together with the addresses in use as profile.
+func saveProfile(c *cli.Context, name string, credentials *messages.Credentials) error {
+	path := configPath(c)
+	loaded, err := loadConfig(path)
+	if err != nil {
+		return err
+	}
+	loaded.Profiles[name] = &profile{
+		Remote: c.GlobalString("remote"),
+		Bind:   c.GlobalString("bind"),
+		ID:     credentials.Id,
+		Token:  credentials.Token,
+	}
+	return saveConfig(path, loaded)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Number of retries and the wait before the first one, set with --retries and --retry-backoff.
+// The wait doubles with each further retry up to maxRetryBackoff.
+var (
+	retries      = 3
+	retryBackoff = 200 * time.Millisecond
+)
+
+const maxRetryBackoff = 5 * time.Second
+
+// Error of an attempt which the treeservice rejected because of its rate limits. The next attempt waits at least
+// until the time the treeservice suggested.
+type rateLimitedError struct {
+	*messages.RateLimitedError
+}
+
+func (e *rateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit of %s %s exceeded", e.Scope, e.Key)
+}
+
+// Calls attempt until it succeeds, fails with retry false or all retries are used up. Logs each failed attempt
+// and, if it wasn't the first one, the attempt which succeeded.
+func withRetries(operation string, attempt func() (retry bool, err error)) error {
+	backoff := retryBackoff
+	for n := 1; ; n++ {
+		retry, err := attempt()
+		if err == nil {
+			if n > 1 {
+				log.Printf("Attempt %d of %d to %s succeeded", n, retries+1, operation)
+			}
+			return nil
+		}
+		if !retry || n > retries {
+			return err
+		}
+		wait := backoff
+		if limited, ok := err.(*rateLimitedError); ok && time.Duration(limited.RetryAfter)*time.Millisecond > wait {
+			wait = time.Duration(limited.RetryAfter) * time.Millisecond
+		}
+		log.Printf("Attempt %d of %d to %s failed: %v, retrying in %s", n, retries+1, operation, err, wait)
+		time.Sleep(wait)
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// Returns whether sending the request again is safe. Reads are, as are mutating requests with idempotency key,
+// which the treeservice doesn't apply twice.
+func retryable(message interface{}) bool {
+	switch msg := message.(type) {
+	case *messages.SearchRequest, *messages.TraverseRequest, *messages.RangeRequest, *messages.TreeInfoRequest:
+		return true
+	case *messages.InsertRequest:
+		return msg.IdempotencyKey != ""
+	case *messages.InsertBatchRequest:
+		return msg.IdempotencyKey != ""
+	case *messages.DeleteRequest:
+		return msg.IdempotencyKey != ""
+	case *messages.CommitTxRequest:
+		return msg.IdempotencyKey != ""
+	}
+	return false
+}
+
+// Sends the request and waits for the response. Retryable requests are sent again with a new deadline
+// if no response, a DeadlineExceededError or an OverloadedError arrives. Requests rejected because of rate limits
+// or a shutdown are always sent again, the treeservice rejected them before applying them. The error response of
+// the last attempt is returned as response.
+func requestWithRetries(context *actor.RootContext, remotePid *actor.PID, message interface{}) (interface{}, error) {
+	var res interface{}
+	operation := "send " + strings.TrimPrefix(fmt.Sprintf("%T", message), "*messages.")
+	err := withRetries(operation, func() (bool, error) {
+		resetDeadline(message)
+		var err error
+		res, err = context.RequestFuture(remotePid, message, responseTimeout()).Result()
+		if err != nil {
+			return retryable(message), err
+		}
+		if late, ok := res.(*messages.DeadlineExceededError); ok {
+			return retryable(message), fmt.Errorf("deadline %s exceeded",
+				time.Unix(0, late.Deadline*int64(time.Millisecond)).Format(time.RFC3339Nano),
+			)
+		}
+		if limited, ok := res.(*messages.RateLimitedError); ok {
+			return true, &rateLimitedError{limited}
+		}
+		if _, ok := res.(*messages.ShuttingDownError); ok {
+			return true, errors.New("treeservice shutting down")
+		}
+		if overloaded, ok := res.(*messages.OverloadedError); ok {
+			return retryable(message), fmt.Errorf("node %s overloaded", overloaded.Node)
+		}
+		return false, nil
+	})
+	switch res.(type) {
+	case *messages.DeadlineExceededError, *messages.RateLimitedError, *messages.OverloadedError,
+		*messages.ShuttingDownError:
+		return res, nil
+	}
+	return res, err
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// One line of a script. id, token and value may contain variables like ${token}.
+type scriptStep struct {
+	Request string          `json:"request"`
+	ID      string          `json:"id"`
+	Token   string          `json:"token"`
+	MaxSize int64           `json:"maxSize"`
+	Key     int64           `json:"key"`
+	Value   string          `json:"value"`
+	From    int64           `json:"from"`
+	To      int64           `json:"to"`
+	As      string          `json:"as"`
+	Expect  json.RawMessage `json:"expect"`
+}
+
+var scriptVariable = regexp.MustCompile(`\$\{([\w.]+)\}`)
+
+// Replaces the variables in text by their values.
+func substitute(text string, variables map[string]string) (string, error) {
+	var missing string
+	result := scriptVariable.ReplaceAllStringFunc(text, func(match string) string {
+		name := scriptVariable.FindStringSubmatch(match)[1]
+		value, exists := variables[name]
+		if !exists && missing == "" {
+			missing = name
+		}
+		return value
+	})
+	if missing != "" {
+		return "", fmt.Errorf("undefined variable %s", missing)
+	}
+	return result, nil
+}
+
+// Returns the credentials of the step. Without id and token the last created tree is used.
+func (step *scriptStep) credentials(variables map[string]string) (*messages.Credentials, error) {
+	id, token := step.ID, step.Token
+	if id == "" {
+		id = "${id}"
+	}
+	if token == "" {
+		token = "${token}"
+	}
+	id, err := substitute(id, variables)
+	if err != nil {
+		return nil, err
+	}
+	token, err = substitute(token, variables)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id %s", id)
+	}
+	return &messages.Credentials{Id: parsed, Token: token}, nil
+}
+
+// Returns the request to send for the step.
+func (step *scriptStep) message(variables map[string]string) (interface{}, error) {
+	if step.Request == "create" {
+		maxSize := step.MaxSize
+		if maxSize == 0 {
+			maxSize = 2
+		}
+		return &messages.CreateTreeRequest{MaxSize: maxSize}, nil
+	}
+	credentials, err := step.credentials(variables)
+	if err != nil {
+		return nil, err
+	}
+	switch step.Request {
+	case "insert":
+		value, err := substitute(step.Value, variables)
+		if err != nil {
+			return nil, err
+		}
+		return &messages.InsertRequest{Credentials: credentials, Item: &messages.Item{Key: step.Key, Value: value}}, nil
+	case "search":
+		return &messages.SearchRequest{Credentials: credentials, Key: step.Key}, nil
+	case "delete":
+		return &messages.DeleteRequest{Credentials: credentials, Key: step.Key}, nil
+	case "traverse":
+		return &messages.TraverseRequest{Credentials: credentials}, nil
+	case "range":
+		return &messages.RangeRequest{Credentials: credentials, From: step.From, To: step.To}, nil
+	case "info":
+		return &messages.TreeInfoRequest{Credentials: credentials}, nil
+	case "deletetree":
+		return &messages.DeleteTreeRequest{Credentials: credentials}, nil
+	}
+	return nil, fmt.Errorf("unknown request %s", step.Request)
+}
+
+// Compares the fields given in expected with the row. Fields missing in expected aren't compared.
+func matchRow(expected map[string]interface{}, columns []string, row []interface{}) error {
+	for name, value := range expected {
+		found := false
+		for i, column := range columns {
+			if column != name {
+				continue
+			}
+			found = true
+			if fmt.Sprint(value) != fmt.Sprint(row[i]) {
+				return fmt.Errorf("expected %s %v but got %v", name, value, row[i])
+			}
+		}
+		if !found {
+			return fmt.Errorf("expected %s %v but response has no %s", name, value, name)
+		}
+	}
+	return nil
+}
+
+// Checks the response against the expectation of the step.
+// An object is compared with the only row of the response, a list with all rows.
+func (step *scriptStep) check(response *table, variables map[string]string) error {
+	if len(step.Expect) == 0 {
+		return nil
+	}
+	if response == nil {
+		return fmt.Errorf("unexpected response")
+	}
+	expect, err := substitute(string(step.Expect), variables)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(strings.NewReader(expect))
+	decoder.UseNumber()
+	if bytes.HasPrefix(bytes.TrimSpace([]byte(expect)), []byte("[")) {
+		var expected []map[string]interface{}
+		if err := decoder.Decode(&expected); err != nil {
+			return err
+		}
+		if len(expected) != len(response.rows) {
+			return fmt.Errorf("expected %d results but got %d", len(expected), len(response.rows))
+		}
+		for i := range expected {
+			if err := matchRow(expected[i], response.columns, response.rows[i]); err != nil {
+				return fmt.Errorf("result %d: %v", i+1, err)
+			}
+		}
+		return nil
+	}
+	var expected map[string]interface{}
+	if err := decoder.Decode(&expected); err != nil {
+		return err
+	}
+	if len(response.rows) != 1 {
+		return fmt.Errorf("expected one result but got %d", len(response.rows))
+	}
+	return matchRow(expected, response.columns, response.rows[0])
+}
+
+// Executes the requests of a JSON lines file one after the other and prints the responses.
+// create defines the variables id and token and with "as" also <as>.id and <as>.token.
+// Stops at the first response not matching its expectation.
+func runScript(context *actor.RootContext, remotePid *actor.PID, path string, printer *responsePrinter) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	variables := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	checked := 0
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		step := &scriptStep{}
+		if err := json.Unmarshal(scanner.Bytes(), step); err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		message, err := step.message(variables)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		res, err := requestWithRetries(context, remotePid, message)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		printer.print(res)
+		if created, ok := res.(*messages.CreateTreeResponse); ok {
+			id := strconv.FormatInt(created.Credentials.Id, 10)
+			variables["id"], variables["token"] = id, created.Credentials.Token
+			if step.As != "" {
+				variables[step.As+".id"], variables[step.As+".token"] = id, created.Credentials.Token
+			}
+		}
+		response, _ := tableOf(res)
+		if err := step.check(response, variables); err != nil {
+			return &responseError{fmt.Sprintf("line %d: %s: %v", line, step.Request, err), exitAssertionFailed}
+		}
+		if len(step.Expect) > 0 {
+			checked++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	log.Printf("All %d expectations of %s met", checked, path)
+	return nil
+}
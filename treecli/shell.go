@@ -0,0 +1,377 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/peterh/liner"
+)
+
+const shellHistoryFile = ".treecli_history"
+
+// Interactive session sending all commands over one connection to the treeservice.
+type shell struct {
+	context     *actor.RootContext
+	remotePid   *actor.PID
+	credentials *messages.Credentials
+	snapshot    *messages.Snapshot
+	printer     *responsePrinter
+	tracer      *tracer
+	done        bool
+}
+
+// Command of the shell. run returns the message to send to the treeservice or nil if there is none.
+// Commands with needsTree are only run once use or create selected a tree.
+type shellCommand struct {
+	args        string
+	description string
+	needsTree   bool
+	run         func(sh *shell, args []string) (interface{}, error)
+}
+
+var errUsage = errors.New("wrong number of arguments")
+
+var shellCommands = map[string]shellCommand{
+	"use": {
+		args:        "id token",
+		description: "use the specified tree for the following commands",
+		run: func(sh *shell, args []string) (interface{}, error) {
+			if len(args) != 2 {
+				return nil, errUsage
+			}
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			sh.credentials = &messages.Credentials{Id: id, Token: args[1]}
+			sh.snapshot = nil
+			return nil, nil
+		},
+	},
+	"at": {
+		args:        "snapshotId|latest",
+		description: "read from the snapshot or the latest values",
+		run: func(sh *shell, args []string) (interface{}, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			if args[0] == "latest" {
+				sh.snapshot = nil
+				return nil, nil
+			}
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			sh.snapshot = &messages.Snapshot{Id: id}
+			return nil, nil
+		},
+	},
+	"create": {
+		args:        "[maxSize=2]",
+		description: "create a new search tree and use it",
+		run: func(sh *shell, args []string) (interface{}, error) {
+			maxSize := int64(2)
+			if len(args) > 0 {
+				var err error
+				if maxSize, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return nil, err
+				}
+			}
+			return &messages.CreateTreeRequest{MaxSize: maxSize}, nil
+		},
+	},
+	"insert": {
+		args:        "key value",
+		description: "insert key-value pair into tree",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			if len(args) < 2 {
+				return nil, errUsage
+			}
+			key, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			value := strings.Join(args[1:], " ")
+			return &messages.InsertRequest{Credentials: sh.credentials, Item: &messages.Item{Key: key, Value: value}}, nil
+		},
+	},
+	"search": {
+		args:        "key",
+		description: "search value specified by key in tree",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			key, err := parseKeys(args, 1)
+			if err != nil {
+				return nil, err
+			}
+			return &messages.SearchRequest{Credentials: sh.credentials, Key: key[0], Snapshot: sh.snapshot}, nil
+		},
+	},
+	"delete": {
+		args:        "key",
+		description: "delete key-value pair in tree",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			key, err := parseKeys(args, 1)
+			if err != nil {
+				return nil, err
+			}
+			return &messages.DeleteRequest{Credentials: sh.credentials, Key: key[0]}, nil
+		},
+	},
+	"range": {
+		args:        "from to",
+		description: "get key-value pairs with from <= key <= to",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			keys, err := parseKeys(args, 2)
+			if err != nil {
+				return nil, err
+			}
+			return &messages.RangeRequest{
+				Credentials: sh.credentials,
+				From:        keys[0],
+				To:          keys[1],
+				Snapshot:    sh.snapshot,
+			}, nil
+		},
+	},
+	"traverse": {
+		args:        "",
+		description: "get all key-value pairs sorted by key",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			return &messages.TraverseRequest{Credentials: sh.credentials, Snapshot: sh.snapshot}, nil
+		},
+	},
+	"snapshot": {
+		args:        "",
+		description: "take a snapshot of the tree",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			return &messages.SnapshotRequest{Credentials: sh.credentials}, nil
+		},
+	},
+	"release": {
+		args:        "snapshotId",
+		description: "release a snapshot of the tree",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			id, err := parseKeys(args, 1)
+			if err != nil {
+				return nil, err
+			}
+			return &messages.ReleaseSnapshotRequest{Credentials: sh.credentials, SnapshotId: id[0]}, nil
+		},
+	},
+	"info": {
+		args:        "",
+		description: "show settings of tree",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			return &messages.TreeInfoRequest{Credentials: sh.credentials}, nil
+		},
+	},
+	"clone": {
+		args:        "",
+		description: "copy tree into a new tree",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			return &messages.CloneTreeRequest{Credentials: sh.credentials}, nil
+		},
+	},
+	"deletetree": {
+		args:        "token",
+		description: "remove tree, the token must be repeated",
+		needsTree:   true,
+		run: func(sh *shell, args []string) (interface{}, error) {
+			if len(args) != 1 || args[0] != sh.credentials.Token {
+				return nil, errors.New("token doesn't match - tree remains")
+			}
+			return &messages.DeleteTreeRequest{Credentials: sh.credentials}, nil
+		},
+	},
+	"exit": {
+		args:        "",
+		description: "leave the shell",
+		run: func(sh *shell, args []string) (interface{}, error) {
+			sh.done = true
+			return nil, nil
+		},
+	},
+}
+
+func parseKeys(args []string, count int) ([]int64, error) {
+	if len(args) != count {
+		return nil, errUsage
+	}
+	keys := make([]int64, count)
+	for i, arg := range args {
+		key, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
+
+func shellCommandNames() []string {
+	names := make([]string, 0, len(shellCommands)+1)
+	for name := range shellCommands {
+		names = append(names, name)
+	}
+	names = append(names, "help")
+	sort.Strings(names)
+	return names
+}
+
+func printShellHelp() {
+	for _, name := range shellCommandNames() {
+		if command, exists := shellCommands[name]; exists {
+			fmt.Printf("  %-28s %s\n", strings.TrimSpace(name+" "+command.args), command.description)
+		}
+	}
+	fmt.Printf("  %-28s %s\n", "help", "show this list")
+}
+
+// Executes one line. Prints the response of the treeservice and how long it took.
+func (sh *shell) execute(line string) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return
+	}
+	if fields[0] == "help" {
+		printShellHelp()
+		return
+	}
+	command, exists := shellCommands[fields[0]]
+	if !exists {
+		fmt.Printf("Unknown command %s - type help for a list of commands\n", fields[0])
+		return
+	}
+	if command.needsTree && sh.credentials == nil {
+		fmt.Println("no tree selected - use or create first")
+		return
+	}
+	message, err := command.run(sh, fields[1:])
+	if err == errUsage {
+		fmt.Printf("Usage: %s %s\n", fields[0], command.args)
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if message == nil {
+		return
+	}
+	start := time.Now()
+	res, err := requestWithRetries(sh.context, sh.remotePid, message)
+	elapsed := time.Since(start)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	sh.printer.print(res)
+	if created, ok := res.(*messages.CreateTreeResponse); ok {
+		sh.credentials = created.Credentials
+		sh.tracer.created(created.Credentials)
+	}
+	if sh.printer.format == "text" {
+		fmt.Printf("(%s)\n", elapsed.Round(time.Microsecond))
+	}
+	if sh.tracer != nil {
+		sh.tracer.printTraces(sh.context, sh.remotePid)
+	}
+}
+
+func (sh *shell) prompt() string {
+	if sh.credentials == nil {
+		return "treecli> "
+	}
+	if sh.snapshot != nil {
+		return fmt.Sprintf("treecli tree %d@%d> ", sh.credentials.Id, sh.snapshot.Id)
+	}
+	return fmt.Sprintf("treecli tree %d> ", sh.credentials.Id)
+}
+
+// Returns the line as kept in the history, with the tokens given to use and deletetree masked.
+func historyEntry(input string) string {
+	fields := strings.Fields(input)
+	tokenAt := map[string]int{"use": 2, "deletetree": 1}
+	if i, ok := tokenAt[fields[0]]; ok && len(fields) > i {
+		fields[i] = "***"
+		return strings.Join(fields, " ")
+	}
+	return input
+}
+
+// Reads commands until exit or EOF. Keeps the history in the home directory of the user.
+func runShell(
+	context *actor.RootContext,
+	remotePid *actor.PID,
+	credentials *messages.Credentials,
+	printer *responsePrinter,
+	trace *tracer,
+) error {
+	sh := &shell{context: context, remotePid: remotePid, credentials: credentials, printer: printer, tracer: trace}
+	line := liner.NewLiner()
+	defer line.Close()
+	line.SetCtrlCAborts(true)
+	line.SetCompleter(func(input string) []string {
+		completions := make([]string, 0)
+		for _, name := range shellCommandNames() {
+			if strings.HasPrefix(name, input) {
+				completions = append(completions, name+" ")
+			}
+		}
+		return completions
+	})
+
+	historyPath := shellHistoryFile
+	if home, err := os.UserHomeDir(); err == nil {
+		historyPath = filepath.Join(home, shellHistoryFile)
+	}
+	if file, err := os.Open(historyPath); err == nil {
+		_, _ = line.ReadHistory(file)
+		file.Close()
+	}
+
+	for !sh.done {
+		input, err := line.Prompt(sh.prompt())
+		if err == io.EOF || err == liner.ErrPromptAborted {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(input) != "" {
+			line.AppendHistory(historyEntry(input))
+		}
+		sh.execute(input)
+	}
+
+	file, err := os.OpenFile(historyPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	// Histories written before may still be readable by others
+	if err := file.Chmod(0600); err != nil {
+		return err
+	}
+	_, err = line.WriteHistory(file)
+	return err
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/tracing"
+)
+
+// Number of traces started until their timelines are printed. Further requests aren't traced.
+const maxTraces = 20
+
+// Actors record their span after handling a message, so the last spans may be recorded shortly after the response.
+const traceSettleTime = 100 * time.Millisecond
+
+// Starts a trace for each request treecli sends and logs the timeline of its spans. Used with --trace.
+type tracer struct {
+	mutex   sync.Mutex
+	started []*startedTrace
+}
+
+// The treeservice gives the spans of a trace only with the credentials of the tree the request went to.
+type startedTrace struct {
+	id          string
+	request     string
+	sentAt      time.Time
+	credentials *messages.Credentials
+}
+
+// Requests to an existing tree.
+type credentialed interface {
+	GetCredentials() *messages.Credentials
+}
+
+// Sender middleware for the root context of treecli.
+func (t *tracer) middleware(next actor.SenderFunc) actor.SenderFunc {
+	return func(c actor.SenderContext, target *actor.PID, envelope *actor.MessageEnvelope) {
+		if _, fetching := envelope.Message.(*messages.TraceRequest); !fetching {
+			t.start(envelope)
+		}
+		next(c, target, envelope)
+	}
+}
+
+func (t *tracer) start(envelope *actor.MessageEnvelope) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if len(t.started) >= maxTraces {
+		return
+	}
+	trace := &startedTrace{
+		id:      tracing.NewTraceID(),
+		request: strings.TrimPrefix(fmt.Sprintf("%T", envelope.Message), "*messages."),
+		sentAt:  time.Now(),
+	}
+	if msg, ok := envelope.Message.(credentialed); ok {
+		trace.credentials = msg.GetCredentials()
+	}
+	tracing.Inject(envelope, trace.id, "")
+	t.started = append(t.started, trace)
+}
+
+// Sets the credentials of a tree created by a traced request, so its trace can be fetched. t may be nil.
+func (t *tracer) created(credentials *messages.Credentials) {
+	if t == nil {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, trace := range t.started {
+		if trace.credentials == nil {
+			trace.credentials = credentials
+		}
+	}
+}
+
+// Fetches the spans of all traces started since the last call from the treeservice and logs their timelines.
+func (t *tracer) printTraces(context *actor.RootContext, remotePid *actor.PID) {
+	t.mutex.Lock()
+	started := t.started
+	t.started = nil
+	t.mutex.Unlock()
+	if len(started) == 0 {
+		return
+	}
+	time.Sleep(traceSettleTime)
+	for _, trace := range started {
+		if trace.credentials == nil {
+			log.Printf("Can't fetch trace %s of %s without credentials", trace.id, trace.request)
+			continue
+		}
+		request := &messages.TraceRequest{TraceId: trace.id, Credentials: trace.credentials}
+		res, err := context.RequestFuture(remotePid, request, responseTimeout()).Result()
+		if err != nil {
+			log.Printf("Failed to fetch trace %s: %v", trace.id, err)
+			continue
+		}
+		if response, ok := res.(*messages.TraceResponse); ok {
+			logTimeline(trace, response.Spans)
+		}
+	}
+}
+
+// Logs one line per span with its start relative to sending the request, the time the message waited
+// in the mailbox and the time the actor took to handle it. Spans are indented below their parent.
+func logTimeline(trace *startedTrace, spans []*messages.Span) {
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].StartedAt < spans[j].StartedAt
+	})
+	log.Printf("Trace %s of %s with %d spans", trace.id, trace.request, len(spans))
+	log.Printf("%12s %12s %12s  %s", "start", "queued", "took", "span")
+	depths := make(map[string]int)
+	for _, span := range spans {
+		depth := 0
+		if parent, known := depths[span.ParentSpanId]; known {
+			depth = parent + 1
+		}
+		depths[span.SpanId] = depth
+		log.Printf("%12s %12s %12s  %s%s %s",
+			"+"+time.Duration(span.StartedAt-trace.sentAt.UnixNano()).Round(time.Microsecond).String(),
+			time.Duration(span.StartedAt-span.QueuedAt).Round(time.Microsecond),
+			time.Duration(span.EndedAt-span.StartedAt).Round(time.Microsecond),
+			strings.Repeat("  ", depth),
+			span.Name,
+			span.Actor,
+		)
+	}
+}
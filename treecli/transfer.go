@@ -0,0 +1,436 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+const importBatchSize = 100
+
+// Largest protobuf message accepted when importing
+const maxMessageSize = 16 * 1024 * 1024
+
+// Writes an exported tree in one of the export formats.
+type exportWriter interface {
+	writeHeader(header *messages.ExportHeader) error
+	writeItem(item *messages.Item) error
+	flush() error
+}
+
+// Reads an exported tree. readItem returns io.EOF after the last item.
+type exportReader interface {
+	readHeader() (*messages.ExportHeader, error)
+	readItem() (*messages.Item, error)
+}
+
+func newExportWriter(format string, w io.Writer) (exportWriter, error) {
+	switch format {
+	case "jsonl":
+		buffered := bufio.NewWriter(w)
+		return &jsonlWriter{buffered: buffered, encoder: json.NewEncoder(buffered)}, nil
+	case "csv":
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case "protobuf":
+		buffered := bufio.NewWriter(w)
+		return &protobufWriter{buffered: buffered, writer: protoio.NewDelimitedWriter(buffered)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %s", format)
+}
+
+func newExportReader(format string, r io.Reader) (exportReader, error) {
+	switch format {
+	case "jsonl":
+		return &jsonlReader{decoder: json.NewDecoder(bufio.NewReader(r))}, nil
+	case "csv":
+		reader := csv.NewReader(bufio.NewReader(r))
+		reader.FieldsPerRecord = -1
+		return &csvReader{reader: reader}, nil
+	case "protobuf":
+		return &protobufReader{reader: protoio.NewDelimitedReader(bufio.NewReader(r), maxMessageSize)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %s", format)
+}
+
+// Guesses the format of an exported tree from the extension of its file.
+func formatOf(path string) string {
+	switch filepath.Ext(path) {
+	case ".csv":
+		return "csv"
+	case ".pb", ".protobuf":
+		return "protobuf"
+	}
+	return "jsonl"
+}
+
+// JSON lines: the header followed by one item per line.
+type jsonlWriter struct {
+	buffered *bufio.Writer
+	encoder  *json.Encoder
+}
+
+func (w *jsonlWriter) writeHeader(header *messages.ExportHeader) error {
+	return w.encoder.Encode(header)
+}
+
+func (w *jsonlWriter) writeItem(item *messages.Item) error {
+	return w.encoder.Encode(item)
+}
+
+func (w *jsonlWriter) flush() error {
+	return w.buffered.Flush()
+}
+
+type jsonlReader struct {
+	decoder *json.Decoder
+}
+
+func (r *jsonlReader) readHeader() (*messages.ExportHeader, error) {
+	header := &messages.ExportHeader{}
+	return header, r.decoder.Decode(header)
+}
+
+func (r *jsonlReader) readItem() (*messages.Item, error) {
+	item := &messages.Item{}
+	return item, r.decoder.Decode(item)
+}
+
+// CSV: a record with the names of the header fields and one with their values,
+// followed by a record with the names of the item fields and one record per item.
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvWriter) writeHeader(header *messages.ExportHeader) error {
+	if err := w.writer.Write([]string{"maxSize", "itemCount"}); err != nil {
+		return err
+	}
+	if err := w.writer.Write([]string{
+		strconv.FormatInt(header.MaxSize, 10),
+		strconv.FormatInt(header.ItemCount, 10),
+	}); err != nil {
+		return err
+	}
+	return w.writer.Write([]string{"key", "value", "expiresAt"})
+}
+
+func (w *csvWriter) writeItem(item *messages.Item) error {
+	return w.writer.Write([]string{
+		strconv.FormatInt(item.Key, 10),
+		item.Value,
+		strconv.FormatInt(item.ExpiresAt, 10),
+	})
+}
+
+func (w *csvWriter) flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type csvReader struct {
+	reader *csv.Reader
+}
+
+func (r *csvReader) readHeader() (*messages.ExportHeader, error) {
+	if _, err := r.reader.Read(); err != nil {
+		return nil, err
+	}
+	values, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 2 {
+		return nil, fmt.Errorf("expected maxSize and itemCount but got %v", values)
+	}
+	header := &messages.ExportHeader{}
+	if header.MaxSize, err = strconv.ParseInt(values[0], 10, 64); err != nil {
+		return nil, err
+	}
+	if header.ItemCount, err = strconv.ParseInt(values[1], 10, 64); err != nil {
+		return nil, err
+	}
+	_, err = r.reader.Read()
+	return header, err
+}
+
+func (r *csvReader) readItem() (*messages.Item, error) {
+	values, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 3 {
+		return nil, fmt.Errorf("expected key, value and expiresAt but got %v", values)
+	}
+	item := &messages.Item{Value: values[1]}
+	if item.Key, err = strconv.ParseInt(values[0], 10, 64); err != nil {
+		return nil, err
+	}
+	if item.ExpiresAt, err = strconv.ParseInt(values[2], 10, 64); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Protobuf: the header followed by the items, each prefixed with its length as varint.
+type protobufWriter struct {
+	buffered *bufio.Writer
+	writer   protoio.WriteCloser
+}
+
+func (w *protobufWriter) writeHeader(header *messages.ExportHeader) error {
+	return w.writer.WriteMsg(header)
+}
+
+func (w *protobufWriter) writeItem(item *messages.Item) error {
+	return w.writer.WriteMsg(item)
+}
+
+func (w *protobufWriter) flush() error {
+	return w.buffered.Flush()
+}
+
+type protobufReader struct {
+	reader protoio.ReadCloser
+}
+
+func (r *protobufReader) readHeader() (*messages.ExportHeader, error) {
+	header := &messages.ExportHeader{}
+	return header, r.reader.ReadMsg(header)
+}
+
+func (r *protobufReader) readItem() (*messages.Item, error) {
+	item := &messages.Item{}
+	return item, r.reader.ReadMsg(item)
+}
+
+// Sends the message to the treeservice and waits for the response. Error messages are returned as error.
+func request(context *actor.RootContext, remotePid *actor.PID, message interface{}) (interface{}, error) {
+	res, err := requestWithRetries(context, remotePid, message)
+	if err != nil {
+		return nil, err
+	}
+	switch msg := res.(type) {
+	case *messages.NoSuchTreeError:
+		return nil, &responseError{fmt.Sprintf("no tree with id %d", msg.Id), exitNoSuchTree}
+	case *messages.InvalidTokenError:
+		return nil, &responseError{
+			fmt.Sprintf("invalid token %s for tree %d", msg.Credentials.Token, msg.Credentials.Id),
+			exitInvalidToken,
+		}
+	case *messages.NoSuchSnapshotError:
+		return nil, &responseError{fmt.Sprintf("no snapshot with id %d", msg.SnapshotId), exitNoSuchSnapshot}
+	case *messages.DeadlineExceededError:
+		return nil, &responseError{
+			fmt.Sprintf("deadline %s exceeded",
+				time.Unix(0, msg.Deadline*int64(time.Millisecond)).Format(time.RFC3339Nano),
+			),
+			exitDeadlineExceeded,
+		}
+	case *messages.QuotaExceededError:
+		return nil, &responseError{
+			fmt.Sprintf("quota of %s exceeded, %s is %d but %d requested", msg.Scope, msg.Limit, msg.Max, msg.Requested),
+			exitQuotaExceeded,
+		}
+	case *messages.RateLimitedError:
+		return nil, &responseError{fmt.Sprintf("rate limit of %s %s exceeded", msg.Scope, msg.Key), exitRateLimited}
+	case *messages.OverloadedError:
+		return nil, &responseError{fmt.Sprintf("node %s is overloaded", msg.Node), exitOverloaded}
+	case *messages.ShuttingDownError:
+		return nil, &responseError{"the treeservice is shutting down", exitShuttingDown}
+	case *messages.InvalidRequestError:
+		return nil, &responseError{fmt.Sprintf("invalid request: %s", msg.Reason), exitInvalidRequest}
+	}
+	return res, nil
+}
+
+// Returns the error for a response of another type than expected, e.g. an error request doesn't convert.
+func unexpectedResponse(res interface{}) error {
+	return fmt.Errorf("unexpected response %T from the treeservice", res)
+}
+
+// Writes all items of the tree as they are in a snapshot taken for the export.
+func exportTree(
+	context *actor.RootContext,
+	remotePid *actor.PID,
+	credentials *messages.Credentials,
+	writer exportWriter,
+) error {
+	res, err := request(context, remotePid, &messages.TreeInfoRequest{Credentials: credentials})
+	if err != nil {
+		return err
+	}
+	info, ok := res.(*messages.TreeInfoResponse)
+	if !ok {
+		return unexpectedResponse(res)
+	}
+	res, err = request(context, remotePid, &messages.SnapshotRequest{Credentials: credentials})
+	if err != nil {
+		return err
+	}
+	snapshot, ok := res.(*messages.SnapshotResponse)
+	if !ok {
+		return unexpectedResponse(res)
+	}
+	snapshotID := snapshot.SnapshotId
+	defer func() {
+		_, _ = request(context, remotePid, &messages.ReleaseSnapshotRequest{
+			Credentials: credentials,
+			SnapshotId:  snapshotID,
+		})
+	}()
+	res, err = request(context, remotePid, &messages.TraverseRequest{
+		Credentials: credentials,
+		Snapshot:    &messages.Snapshot{Id: snapshotID},
+	})
+	if err != nil {
+		return err
+	}
+	traversal, ok := res.(*messages.TraverseResponse)
+	if !ok {
+		return unexpectedResponse(res)
+	}
+	items := traversal.Items
+	if err := writer.writeHeader(&messages.ExportHeader{MaxSize: info.MaxSize, ItemCount: int64(len(items))}); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := writer.writeItem(item); err != nil {
+			return err
+		}
+	}
+	log.Printf("Exported %d items of tree %d", len(items), credentials.Id)
+	return writer.flush()
+}
+
+// State of an import, saved next to the imported file so an interrupted import can be resumed.
+type importProgress struct {
+	Credentials *messages.Credentials `json:"credentials"`
+	Imported    int64                 `json:"imported"`
+}
+
+func progressPath(path string) string {
+	return path + ".import"
+}
+
+func loadProgress(path string) (*importProgress, error) {
+	data, err := ioutil.ReadFile(progressPath(path))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	progress := &importProgress{}
+	return progress, json.Unmarshal(data, progress)
+}
+
+func saveProgress(path string, progress *importProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(progressPath(path), data, 0600)
+}
+
+// Creates a new tree with the maxSize of the exported tree and inserts its items in batches.
+// Resumes the import into the same tree if a previous import of the file was interrupted.
+// Returns the credentials of the new tree.
+func importTree(
+	context *actor.RootContext,
+	remotePid *actor.PID,
+	path, format string,
+) (*messages.Credentials, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	reader, err := newExportReader(format, file)
+	if err != nil {
+		return nil, err
+	}
+	header, err := reader.readHeader()
+	if err != nil {
+		return nil, err
+	}
+	progress, err := loadProgress(path)
+	if err != nil {
+		return nil, err
+	}
+	if progress == nil {
+		res, err := request(context, remotePid, &messages.CreateTreeRequest{MaxSize: header.MaxSize})
+		if err != nil {
+			return nil, err
+		}
+		created, ok := res.(*messages.CreateTreeResponse)
+		if !ok {
+			return nil, unexpectedResponse(res)
+		}
+		progress = &importProgress{Credentials: created.Credentials}
+		if err := saveProgress(path, progress); err != nil {
+			return nil, err
+		}
+		log.Printf("Importing %d items into new tree %d", header.ItemCount, progress.Credentials.Id)
+	} else {
+		log.Printf("Resuming import into tree %d after %d of %d items",
+			progress.Credentials.Id,
+			progress.Imported,
+			header.ItemCount,
+		)
+	}
+	for read := int64(0); read < progress.Imported; read++ {
+		if _, err := reader.readItem(); err != nil {
+			return nil, err
+		}
+	}
+	for done := false; !done; {
+		batch := make([]*messages.Item, 0, importBatchSize)
+		for len(batch) < importBatchSize {
+			item, err := reader.readItem()
+			if err == io.EOF {
+				done = true
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			batch = append(batch, item)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		res, err := request(context, remotePid, &messages.InsertBatchRequest{
+			Credentials: progress.Credentials,
+			Items:       batch,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%v - delete %s to start over with a new tree", err, progressPath(path))
+		}
+		response, ok := res.(*messages.InsertBatchResponse)
+		if !ok {
+			return nil, fmt.Errorf("%v - delete %s to start over with a new tree", unexpectedResponse(res), progressPath(path))
+		}
+		// Existing keys were inserted before the import was interrupted
+		if len(response.Existing) > 0 {
+			log.Printf("Skipped %d items already in tree %d", len(response.Existing), progress.Credentials.Id)
+		}
+		progress.Imported += int64(len(batch))
+		if err := saveProgress(path, progress); err != nil {
+			return nil, err
+		}
+		log.Printf("Imported %d/%d items", progress.Imported, header.ItemCount)
+	}
+	return progress.Credentials, os.Remove(progressPath(path))
+}
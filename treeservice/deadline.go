@@ -0,0 +1,33 @@
+package main
+
+import (
+	"time"
+
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Deadline of requests which come without one, relative to their arrival.
+const defaultTimeout = 60 * time.Second
+
+// The gateways wait this much longer than the deadline of a request for the DeadlineExceededError.
+const deadlineGrace = time.Second
+
+// Sets the deadline of the message if it is a request without one.
+func assignDeadline(message interface{}, deadline time.Time) {
+	if msg, ok := message.(messages.Deadlined); ok && msg.GetDeadline() == 0 {
+		msg.SetDeadline(messages.MillisOf(deadline))
+	}
+}
+
+// Sets the deadline of the message if it is a request without one or with a later one.
+func shortenDeadline(message interface{}, deadline time.Time) {
+	millis := messages.MillisOf(deadline)
+	if msg, ok := message.(messages.Deadlined); ok && (msg.GetDeadline() == 0 || msg.GetDeadline() > millis) {
+		msg.SetDeadline(millis)
+	}
+}
+
+// Returns the time left until the deadline of the message or defaultTimeout if it has none.
+func timeLeft(message interface{}) time.Duration {
+	return messages.TimeLeft(message, defaultTimeout)
+}
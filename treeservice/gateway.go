@@ -0,0 +1,262 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+)
+
+// Time the gateway waits for the treeservice to answer a request.
+const gatewayTimeout = 60 * time.Second
+
+// Translates REST requests into messages for the treeservice actor and its responses back into JSON.
+//
+//	POST   /trees                     create a tree, body {"maxSize": 2, "ttl": ms, "idleTimeout": ms,
+//	                                  "quota": {"maxItems": n, "maxValueBytes": n, "maxTotalBytes": n}}
+//	GET    /trees/{id}                settings of the tree
+//	DELETE /trees/{id}                delete the tree
+//	PUT    /trees/{id}/items/{key}    insert an item, body {"value": "...", "ttl": ms}
+//	GET    /trees/{id}/items/{key}    search an item
+//	DELETE /trees/{id}/items/{key}    delete an item
+//	GET    /trees/{id}/items          all items or with ?from=&to= a range, ?snapshot= reads from a snapshot
+//
+// All requests except POST /trees need the token of the tree in the Authorization header.
+// PUT and DELETE of items take an optional Idempotency-Key header, repeating them with the same key doesn't apply
+// them again but returns the first response. Rate limited requests get 429 with a Retry-After header in seconds.
+type gateway struct {
+	service *actor.PID
+}
+
+type createTreeBody struct {
+	MaxSize     int64           `json:"maxSize"`
+	TTL         int64           `json:"ttl"`
+	IdleTimeout int64           `json:"idleTimeout"`
+	Quota       *messages.Quota `json:"quota"`
+}
+
+type insertBody struct {
+	Value string `json:"value"`
+	TTL   int64  `json:"ttl"`
+}
+
+// Body of error responses.
+type errorBody struct {
+	Error  string      `json:"error"`
+	Detail interface{} `json:"detail,omitempty"`
+}
+
+// HTTP status codes of the error messages of the treeservice.
+func statusOf(response interface{}) int {
+	switch response.(type) {
+	case *messages.NoSuchTreeError, *messages.NoSuchKeyError, *messages.NoSuchSnapshotError, *messages.NoSuchTxError:
+		return http.StatusNotFound
+	case *messages.InvalidTokenError:
+		return http.StatusForbidden
+	case *messages.InvalidRequestError:
+		return http.StatusBadRequest
+	case *messages.KeyAlreadyExistsError, *messages.TxAbortedError:
+		return http.StatusConflict
+	case *messages.KeyLockedError:
+		return http.StatusLocked
+	case *messages.DeadlineExceededError:
+		return http.StatusGatewayTimeout
+	case *messages.QuotaExceededError:
+		return http.StatusInsufficientStorage
+	case *messages.RateLimitedError:
+		return http.StatusTooManyRequests
+	case *messages.OverloadedError, *messages.ShuttingDownError:
+		return http.StatusServiceUnavailable
+	case *messages.CreateTreeResponse, *messages.InsertResponse:
+		return http.StatusCreated
+	}
+	return http.StatusOK
+}
+
+// Serves the REST gateway for the treeservice actor on the given address in the background.
+// Returns the server for shutting it down.
+func serveGateway(address string, service *actor.PID) *http.Server {
+	server := &http.Server{Addr: address, Handler: &gateway{service: service}}
+	go func() {
+		logrus.Infof("Treeservice serves REST gateway on http://%s/trees", address)
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			logrus.Errorf("REST gateway stopped: %v", err)
+		}
+	}()
+	return server
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "trees" || len(parts) > 4 || len(parts) >= 3 && parts[2] != "items" {
+		writeError(w, http.StatusNotFound, "NotFound", r.URL.Path)
+		return
+	}
+	if len(parts) == 1 {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+			return
+		}
+		g.createTree(w, r)
+		return
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidId", parts[1])
+		return
+	}
+	credentials := &messages.Credentials{Id: id, Token: tokenOf(r)}
+	if credentials.Token == "" {
+		writeError(w, http.StatusUnauthorized, "MissingToken", "Authorization header with the token of the tree required")
+		return
+	}
+	var message interface{}
+	switch {
+	case len(parts) == 2:
+		message, err = treeMessage(r, credentials)
+	case len(parts) == 3:
+		message, err = itemsMessage(r, credentials)
+	default:
+		message, err = itemMessage(r, credentials, parts[3])
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+	if message == nil {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+		return
+	}
+	if key := r.Header.Get("Idempotency-Key"); key != "" {
+		setIdempotencyKey(message, key)
+	}
+	g.request(w, r, message)
+}
+
+// Accepts the token as "Bearer <token>" or on its own.
+func tokenOf(r *http.Request) string {
+	return strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+}
+
+func (g *gateway) createTree(w http.ResponseWriter, r *http.Request) {
+	body := &createTreeBody{MaxSize: 2}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+			return
+		}
+	}
+	g.request(w, r, &messages.CreateTreeRequest{
+		MaxSize:     body.MaxSize,
+		Ttl:         body.TTL,
+		IdleTimeout: body.IdleTimeout,
+		Quota:       body.Quota,
+	})
+}
+
+// Returns the message for /trees/{id} or nil if the method isn't supported.
+func treeMessage(r *http.Request, credentials *messages.Credentials) (interface{}, error) {
+	switch r.Method {
+	case http.MethodGet:
+		return &messages.TreeInfoRequest{Credentials: credentials}, nil
+	case http.MethodDelete:
+		return &messages.DeleteTreeRequest{Credentials: credentials}, nil
+	}
+	return nil, nil
+}
+
+// Returns the message for /trees/{id}/items or nil if the method isn't supported.
+func itemsMessage(r *http.Request, credentials *messages.Credentials) (interface{}, error) {
+	if r.Method != http.MethodGet {
+		return nil, nil
+	}
+	query := r.URL.Query()
+	var snapshot *messages.Snapshot
+	if value := query.Get("snapshot"); value != "" {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot %s", value)
+		}
+		snapshot = &messages.Snapshot{Id: id}
+	}
+	if query.Get("from") == "" && query.Get("to") == "" {
+		return &messages.TraverseRequest{Credentials: credentials, Snapshot: snapshot}, nil
+	}
+	from, to := int64(math.MinInt64), int64(math.MaxInt64)
+	var err error
+	if value := query.Get("from"); value != "" {
+		if from, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid from %s", value)
+		}
+	}
+	if value := query.Get("to"); value != "" {
+		if to, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid to %s", value)
+		}
+	}
+	return &messages.RangeRequest{Credentials: credentials, From: from, To: to, Snapshot: snapshot}, nil
+}
+
+// Returns the message for /trees/{id}/items/{key} or nil if the method isn't supported.
+func itemMessage(r *http.Request, credentials *messages.Credentials, rawKey string) (interface{}, error) {
+	key, err := strconv.ParseInt(rawKey, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key %s", rawKey)
+	}
+	switch r.Method {
+	case http.MethodPut:
+		body := &insertBody{}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			return nil, err
+		}
+		return &messages.InsertRequest{
+			Credentials: credentials,
+			Item:        &messages.Item{Key: key, Value: body.Value},
+			Ttl:         body.TTL,
+		}, nil
+	case http.MethodGet:
+		return &messages.SearchRequest{Credentials: credentials, Key: key}, nil
+	case http.MethodDelete:
+		return &messages.DeleteRequest{Credentials: credentials, Key: key}, nil
+	}
+	return nil, nil
+}
+
+// Sends the message to the treeservice on behalf of the client of r with a deadline gatewayTimeout from now and
+// writes its response as JSON.
+func (g *gateway) request(w http.ResponseWriter, r *http.Request, message interface{}) {
+	assignDeadline(message, time.Now().Add(gatewayTimeout))
+	res, err := requestForClient(g.service, message, r.RemoteAddr, timeLeft(message)+deadlineGrace).Result()
+	if err != nil {
+		writeError(w, http.StatusGatewayTimeout, "Timeout", err.Error())
+		return
+	}
+	status := statusOf(res)
+	if limited, ok := res.(*messages.RateLimitedError); ok {
+		w.Header().Set("Retry-After", strconv.FormatInt((limited.RetryAfter+999)/1000, 10))
+	}
+	if status >= http.StatusBadRequest {
+		writeError(w, status, messages.TypeOf(res), res)
+		return
+	}
+	writeJSON(w, status, res)
+}
+
+func writeError(w http.ResponseWriter, status int, name string, detail interface{}) {
+	writeJSON(w, status, &errorBody{Error: name, Detail: detail})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logrus.Warnf("REST gateway failed to write response: %v", err)
+	}
+}
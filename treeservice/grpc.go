@@ -0,0 +1,436 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Number of events buffered per watch stream before the watcher blocks.
+const watchBuffer = 128
+
+// Implements messages.TreeServiceServer by passing each request on to the treeservice actor.
+// Error responses of the treeservice become gRPC status errors whose message starts with the error name.
+type grpcServer struct {
+	service *actor.PID
+}
+
+// Serves the gRPC TreeService on the given address in the background. Returns the server for shutting it down
+// or nil if the address can't be listened on.
+func serveGRPC(address string, service *actor.PID) *grpc.Server {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		logrus.Errorf("gRPC TreeService failed to listen on %s: %v", address, err)
+		return nil
+	}
+	server := grpc.NewServer()
+	messages.RegisterTreeServiceServer(server, &grpcServer{service: service})
+	go func() {
+		logrus.Infof("Treeservice serves gRPC TreeService on %s", address)
+		if err := server.Serve(listener); err != nil {
+			logrus.Errorf("gRPC TreeService stopped: %v", err)
+		}
+	}()
+	return server
+}
+
+// gRPC status code of the error messages of the treeservice.
+func codeOf(response interface{}) codes.Code {
+	switch response.(type) {
+	case *messages.NoSuchTreeError, *messages.NoSuchKeyError, *messages.NoSuchSnapshotError, *messages.NoSuchTxError:
+		return codes.NotFound
+	case *messages.InvalidTokenError:
+		return codes.PermissionDenied
+	case *messages.InvalidRequestError:
+		return codes.InvalidArgument
+	case *messages.KeyAlreadyExistsError:
+		return codes.AlreadyExists
+	case *messages.KeyLockedError:
+		return codes.FailedPrecondition
+	case *messages.TxAbortedError:
+		return codes.Aborted
+	case *messages.DeadlineExceededError:
+		return codes.DeadlineExceeded
+	case *messages.QuotaExceededError, *messages.RateLimitedError:
+		return codes.ResourceExhausted
+	case *messages.OverloadedError, *messages.ShuttingDownError:
+		return codes.Unavailable
+	}
+	return codes.OK
+}
+
+// Returns the response as status error or nil if it is no error.
+func statusError(response interface{}) error {
+	code := codeOf(response)
+	if code == codes.OK {
+		return nil
+	}
+	return status.Error(code, fmt.Sprintf("%s: %v", messages.TypeOf(response), response))
+}
+
+// Returns an Internal error for a response of the treeservice which doesn't fit the request.
+func unexpected(response interface{}) error {
+	return status.Errorf(codes.Internal, "unexpected response %s", messages.TypeOf(response))
+}
+
+// Returns an InvalidArgument error if the request is invalid. gRPC clients may leave out any field.
+func validate(message interface{}) error {
+	if reason := invalidReason(message); reason != "" {
+		return status.Error(codes.InvalidArgument, reason)
+	}
+	return nil
+}
+
+// Returns the address of the client of the call or an empty string if it is unknown.
+func peerOf(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// Checks the required fields of the message, sends it to the treeservice on behalf of the peer of the call with
+// the deadline of the call, but not later than the REST gateway would set it, and waits for the response.
+func (s *grpcServer) request(ctx context.Context, message interface{}) (interface{}, error) {
+	if err := validate(message); err != nil {
+		return nil, err
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > gatewayTimeout {
+		deadline = time.Now().Add(gatewayTimeout)
+	}
+	shortenDeadline(message, deadline)
+	res, err := requestForClient(s.service, message, peerOf(ctx), timeLeft(message)+deadlineGrace).Result()
+	if err != nil {
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if err := statusError(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *grpcServer) CreateTree(
+	ctx context.Context,
+	req *messages.CreateTreeRequest,
+) (*messages.CreateTreeResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.CreateTreeResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) CloneTree(
+	ctx context.Context,
+	req *messages.CloneTreeRequest,
+) (*messages.CloneTreeResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.CloneTreeResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) TreeInfo(ctx context.Context, req *messages.TreeInfoRequest) (*messages.TreeInfoResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.TreeInfoResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) SetTreeExpiry(
+	ctx context.Context,
+	req *messages.SetTreeExpiryRequest,
+) (*messages.SetTreeExpiryResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.SetTreeExpiryResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) DeleteTree(
+	ctx context.Context,
+	req *messages.DeleteTreeRequest,
+) (*messages.DeleteTreeResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.DeleteTreeResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) Insert(ctx context.Context, req *messages.InsertRequest) (*messages.InsertResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.InsertResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) InsertBatch(
+	ctx context.Context,
+	req *messages.InsertBatchRequest,
+) (*messages.InsertBatchResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.InsertBatchResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) Search(ctx context.Context, req *messages.SearchRequest) (*messages.SearchResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.SearchResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) Delete(ctx context.Context, req *messages.DeleteRequest) (*messages.DeleteResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.DeleteResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) Range(ctx context.Context, req *messages.RangeRequest) (*messages.RangeResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.RangeResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) Snapshot(ctx context.Context, req *messages.SnapshotRequest) (*messages.SnapshotResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.SnapshotResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) ReleaseSnapshot(
+	ctx context.Context,
+	req *messages.ReleaseSnapshotRequest,
+) (*messages.ReleaseSnapshotResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.ReleaseSnapshotResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) BeginTx(ctx context.Context, req *messages.BeginTxRequest) (*messages.BeginTxResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.BeginTxResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) StageTx(ctx context.Context, req *messages.StageTxRequest) (*messages.StageTxResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.StageTxResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) CommitTx(ctx context.Context, req *messages.CommitTxRequest) (*messages.CommitTxResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.CommitTxResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) AbortTx(ctx context.Context, req *messages.AbortTxRequest) (*messages.AbortTxResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.AbortTxResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+func (s *grpcServer) Trace(ctx context.Context, req *messages.TraceRequest) (*messages.TraceResponse, error) {
+	res, err := s.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	response, ok := res.(*messages.TraceResponse)
+	if !ok {
+		return nil, unexpected(res)
+	}
+	return response, nil
+}
+
+// Traverses the tree like the actor messages do and streams the items one by one. The tree responds with all items
+// at once, so they are buffered until then. --max-traverse-items limits them, bigger trees are read with ranges.
+func (s *grpcServer) Traverse(req *messages.TraverseRequest, stream messages.TreeService_TraverseServer) error {
+	res, err := s.request(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	response, ok := res.(*messages.TraverseResponse)
+	if !ok {
+		return unexpected(res)
+	}
+	for _, item := range response.Items {
+		if err := stream.Send(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Registers a watcher actor for the tree with the treeservice and streams the events it receives.
+// Ends with NotFound once the tree is deleted.
+func (s *grpcServer) Watch(req *messages.WatchRequest, stream messages.TreeService_WatchServer) error {
+	if err := validate(req); err != nil {
+		return err
+	}
+	received := make(chan interface{}, watchBuffer)
+	done := make(chan struct{})
+	defer close(done)
+	watcher := actor.EmptyRootContext.Spawn(actor.PropsFromFunc(func(context actor.Context) {
+		message := context.Message()
+		if _, ok := message.(*messages.WatchEvent); !ok && codeOf(message) == codes.OK {
+			return
+		}
+		select {
+		case received <- message:
+		case <-done:
+		}
+	}))
+	defer actor.EmptyRootContext.Stop(watcher)
+	sendForClient(s.service, req, peerOf(stream.Context()), watcher)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case message := <-received:
+			event, ok := message.(*messages.WatchEvent)
+			if !ok {
+				return statusError(message)
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Returns the changes a successful write made to a tree.
+func watchEventsOf(request, response interface{}) []*messages.WatchEvent {
+	events := make([]*messages.WatchEvent, 0)
+	switch res := response.(type) {
+	case *messages.InsertResponse:
+		events = append(events, &messages.WatchEvent{Type: messages.INSERTED, Item: res.Item})
+	case *messages.DeleteResponse:
+		events = append(events, &messages.WatchEvent{Type: messages.DELETED, Item: res.Item})
+	case *messages.InsertBatchResponse:
+		req, ok := request.(*messages.InsertBatchRequest)
+		if !ok {
+			break
+		}
+		skipped := make(map[int64]bool)
+		for _, item := range res.Existing {
+			skipped[item.Key] = true
+		}
+		for _, key := range res.Locked {
+			skipped[key] = true
+		}
+		for _, item := range req.Items {
+			if !skipped[item.Key] {
+				events = append(events, &messages.WatchEvent{Type: messages.INSERTED, Item: item})
+			}
+		}
+	case *messages.CommitTxResponse:
+		types := map[messages.TxOperation_Type]messages.WatchEvent_Type{
+			messages.INSERT: messages.INSERTED,
+			messages.UPDATE: messages.UPDATED,
+			messages.DELETE: messages.DELETED,
+		}
+		for _, operation := range res.Operations {
+			events = append(events, &messages.WatchEvent{Type: types[operation.Type], Item: operation.Item})
+		}
+	}
+	return events
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/proto"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+)
+
+// Mutating requests which may carry an idempotency key.
+type idempotent interface {
+	GetIdempotencyKey() string
+}
+
+// Response to the first request with an idempotency key. response is nil while the request is in flight,
+// repetitions arriving meanwhile wait for it. Requests with the key but another fingerprint are no repetitions.
+type idempotentResult struct {
+	fingerprint [sha256.Size]byte
+	response    interface{}
+	waiting     []*actor.PID
+	expiresAt   time.Time
+}
+
+// Tells the treeservice the response to a forwarded request with idempotency key.
+type idempotentResponse struct {
+	key      string
+	response interface{}
+}
+
+// Returns the key the result of the request is kept under or an empty string if the request has no idempotency key.
+// Keys are scoped by tree, so clients of different trees can't see each other's responses.
+func idempotencyKeyOf(treeID int64, message interface{}) string {
+	if msg, ok := message.(idempotent); ok && msg.GetIdempotencyKey() != "" {
+		return fmt.Sprintf("%d/%s", treeID, msg.GetIdempotencyKey())
+	}
+	return ""
+}
+
+// Returns a hash of the request without the fields which differ between its repetitions: request id and deadline,
+// and version and expiry, which the treeservice sets before forwarding it.
+func fingerprintOf(message interface{}) [sha256.Size]byte {
+	msg, ok := message.(proto.Message)
+	if !ok {
+		return [sha256.Size]byte{}
+	}
+	clone := proto.Clone(msg)
+	switch msg := clone.(type) {
+	case *messages.InsertRequest:
+		msg.Version = 0
+		if msg.Ttl > 0 {
+			msg.ExpiresAt = 0
+		}
+	case *messages.InsertBatchRequest:
+		msg.Version = 0
+	case *messages.DeleteRequest:
+		msg.Version = 0
+	}
+	if msg, ok := clone.(messages.Identifiable); ok {
+		msg.SetRequestId("")
+	}
+	if msg, ok := clone.(messages.Deadlined); ok {
+		msg.SetDeadline(0)
+	}
+	data, err := proto.Marshal(clone)
+	if err != nil {
+		logrus.Errorf("Treeservice failed to marshal %s for its fingerprint: %v", messages.TypeOf(message), err)
+	}
+	return sha256.Sum256(data)
+}
+
+// Answers a repetition of a request with the response to the first one or lets it wait for that response.
+// A different request with the same idempotency key is rejected with an InvalidRequestError.
+// Returns false if the current request is no repetition.
+func (state *treeServiceActor) replayed(context actor.Context, treeID int64) bool {
+	key := idempotencyKeyOf(treeID, context.Message())
+	result, exists := state.results[key]
+	if key == "" || !exists {
+		return false
+	}
+	logger := loggerOf(context.Message())
+	if result.fingerprint != fingerprintOf(context.Message()) {
+		logger.Warnf("Treeservice rejects %s, its idempotency key was used for a different request",
+			messages.TypeOf(context.Message()),
+		)
+		state.respond(context, &messages.InvalidRequestError{Reason: "idempotency key used for a different request"})
+		return true
+	}
+	if result.response == nil {
+		logger.Infof("Treeservice lets repeated %s wait for the first one", messages.TypeOf(context.Message()))
+		if context.Sender() != nil {
+			result.waiting = append(result.waiting, context.Sender())
+		}
+		return true
+	}
+	logger.Infof("Treeservice answers repeated %s with the response to the first one", messages.TypeOf(context.Message()))
+	state.respond(context, result.response)
+	return true
+}
+
+// Keeps the response for repetitions and passes it on to those already waiting. Missing responses aren't kept,
+// because the tree may still apply the request, nor are overloads, after which it may apply a repetition.
+// A repetition is forwarded again then.
+func (state *treeServiceActor) storeResult(context actor.Context, msg *idempotentResponse) {
+	result, exists := state.results[msg.key]
+	if !exists {
+		return
+	}
+	for _, waiting := range result.waiting {
+		context.Send(waiting, msg.response)
+	}
+	switch msg.response.(type) {
+	case *messages.DeadlineExceededError, *messages.OverloadedError:
+		delete(state.results, msg.key)
+		return
+	}
+	result.response = msg.response
+	result.waiting = nil
+	result.expiresAt = time.Now().Add(state.config.idempotencyTTL)
+}
+
+// Forgets the responses kept longer than the idempotency ttl.
+func (state *treeServiceActor) purgeResults(now time.Time) {
+	for key, result := range state.results {
+		if result.response != nil && now.After(result.expiresAt) {
+			delete(state.results, key)
+		}
+	}
+	order := make([]string, 0, len(state.results))
+	for _, key := range state.resultOrder {
+		if _, exists := state.results[key]; exists {
+			order = append(order, key)
+		}
+	}
+	state.resultOrder = order
+}
+
+// Starts keeping the result of the request under the key. If maxResults are kept already, the oldest response is
+// forgotten first. Requests still in flight are kept, if all are, the result of this request isn't kept and a
+// repetition is applied again.
+func (state *treeServiceActor) keepResult(key string, request interface{}) {
+	if state.config.maxResults > 0 && len(state.results) >= state.config.maxResults {
+		evicted := false
+		for n := len(state.resultOrder); n > 0 && !evicted; n-- {
+			oldest := state.resultOrder[0]
+			state.resultOrder = state.resultOrder[1:]
+			result, exists := state.results[oldest]
+			switch {
+			case !exists:
+			case result.response == nil:
+				state.resultOrder = append(state.resultOrder, oldest)
+			default:
+				delete(state.results, oldest)
+				evicted = true
+			}
+		}
+		if !evicted {
+			logrus.Warnf("Treeservice keeps no response for idempotency key %s, %d requests with key are in flight",
+				key,
+				len(state.results),
+			)
+			return
+		}
+	}
+	state.results[key] = &idempotentResult{fingerprint: fingerprintOf(request)}
+	state.resultOrder = append(state.resultOrder, key)
+}
+
+// Sets the idempotency key of the mutating requests of the REST gateway, given in its Idempotency-Key header.
+func setIdempotencyKey(message interface{}, key string) {
+	switch msg := message.(type) {
+	case *messages.InsertRequest:
+		msg.IdempotencyKey = key
+	case *messages.DeleteRequest:
+		msg.IdempotencyKey = key
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/sirupsen/logrus"
+)
+
+// Sets level and format of the log output.
+func configureLogging(level, format string) error {
+	parsed, err := logrus.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	logrus.SetLevel(parsed)
+	switch format {
+	case "text":
+		logrus.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	case "json":
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	default:
+		return fmt.Errorf("unknown log format %s, expected text or json", format)
+	}
+	return nil
+}
+
+func newRequestID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return fmt.Sprintf("%x", id)
+}
+
+// Fills in a new request id if the message is a request without one.
+func assignRequestID(message interface{}) {
+	if msg, ok := message.(messages.Identifiable); ok && msg.GetRequestId() == "" {
+		msg.SetRequestId(newRequestID())
+	}
+}
+
+// Returns a logger with the field requestId if the message has one.
+func loggerOf(message interface{}) *logrus.Entry {
+	if id := messages.RequestIDOf(message); id != "" {
+		return logrus.WithField("requestId", id)
+	}
+	return logrus.NewEntry(logrus.StandardLogger())
+}
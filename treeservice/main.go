@@ -3,128 +3,739 @@ package main
 import (
 	"crypto/rand"
 	"fmt"
-	"log"
+	"net/http"
 	"os"
-	"sync"
+	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/remote"
 	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/remotetls"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/tracing"
 	"github.com/ob-vss-ss19/blatt-3-forever_alone/tree"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc"
 )
 
+// Name of the treeservice actor. treecli activates it remotely as "remote", which the activator prefixes.
+const serviceActorName = "Remote$remote"
+
 type treeServiceActor struct {
-	tokens    map[int64]string
-	trees     map[int64]*actor.PID
-	idCounter int64
+	config        serviceConfig
+	tokens        map[int64]string
+	trees         map[int64]*actor.PID
+	maxSizes      map[int64]int64
+	lifetimes     map[int64]*lifetime
+	idCounter     int64
+	transactions  map[int64]*transaction
+	txCounter     int64
+	commits       map[string]*pendingCommit
+	versions      map[int64]int64
+	snapshots     map[int64]*snapshot
+	snapCounter   int64
+	stopPurging   chan struct{}
+	received      time.Time
+	watchers      map[int64][]*actor.PID
+	results       map[string]*idempotentResult
+	resultOrder   []string
+	quotas        map[int64]*messages.Quota
+	usages        map[int64]*treeUsage
+	total         treeUsage
+	reservations  map[string]*reservation
+	treeBuckets   map[int64]*tokenBucket
+	clientBuckets map[string]*tokenBucket
+	shutdown      shutdownState
+}
+
+// Settings of the treeservice given by flags.
+type serviceConfig struct {
+	txTimeout          time.Duration
+	txIdleTimeout      time.Duration
+	purgeInterval      time.Duration
+	defaultTreeTTL     time.Duration
+	defaultIdleTimeout time.Duration
+	idempotencyTTL     time.Duration
+	maxResults         int
+	maxTraverseItems   int64
+	quota              *messages.Quota
+	treeLimit          rateLimit
+	clientLimit        rateLimit
+}
+
+// Tells the treeservice to purge expired items and trees.
+type purgeTick struct{}
+
+// Tells the treeservice to pass changes of a tree on to its watchers.
+type watchNotification struct {
+	treeID int64
+	events []*messages.WatchEvent
+}
+
+// Limits how long a tree lives. Zero values mean no limit.
+type lifetime struct {
+	expiresAt   time.Time
+	idleTimeout time.Duration
+	lastAccess  time.Time
+}
+
+// Applies ttl and idle timeout in milliseconds. Negative values remove the limit, 0 keeps it.
+func (l *lifetime) update(ttl, idleTimeout int64) {
+	switch {
+	case ttl > 0:
+		l.expiresAt = time.Now().Add(time.Duration(ttl) * time.Millisecond)
+	case ttl < 0:
+		l.expiresAt = time.Time{}
+	}
+	switch {
+	case idleTimeout > 0:
+		l.idleTimeout = time.Duration(idleTimeout) * time.Millisecond
+	case idleTimeout < 0:
+		l.idleTimeout = 0
+	}
+}
+
+// Returns the expiry as unix time in milliseconds or 0 if the tree never expires.
+func (l *lifetime) expiresAtMillis() int64 {
+	if l.expiresAt.IsZero() {
+		return 0
+	}
+	return l.expiresAt.UnixNano() / int64(time.Millisecond)
+}
+
+func (l *lifetime) expired(now time.Time) (bool, string) {
+	if !l.expiresAt.IsZero() && !now.Before(l.expiresAt) {
+		return true, fmt.Sprintf("ttl ran out at %s", l.expiresAt.Format(time.RFC3339))
+	}
+	if l.idleTimeout > 0 && now.Sub(l.lastAccess) >= l.idleTimeout {
+		return true, fmt.Sprintf("idle since %s", l.lastAccess.Format(time.RFC3339))
+	}
+	return false, ""
+}
+
+// Version of a tree which stays readable until the snapshot is released.
+type snapshot struct {
+	treeID  int64
+	version int64
+}
+
+// Operations staged for a transaction on one tree. Only the latest operation per key is kept.
+type transaction struct {
+	treeID     int64
+	operations []*messages.TxOperation
+	lastAccess time.Time
+}
+
+// Commit of a transaction, kept under the id of its coordinator. The version is 0 until the coordinator asks for it
+// after all leafs voted to commit.
+type pendingCommit struct {
+	treeID  int64
+	version int64
+}
+
+func (tx *transaction) stage(operation *messages.TxOperation) {
+	for i, staged := range tx.operations {
+		if staged.Item.Key == operation.Item.Key {
+			tx.operations[i] = operation
+			return
+		}
+	}
+	tx.operations = append(tx.operations, operation)
+}
+
+// Checks that the tree exists and the token matches. Responds with an error otherwise.
+// Requests without credentials are answered with an InvalidRequestError.
+func (state *treeServiceActor) authorized(context actor.Context, credentials *messages.Credentials) bool {
+	if credentials == nil {
+		loggerOf(context.Message()).Warnf("Request without credentials... treeservice denies access")
+		state.respond(context, &messages.InvalidRequestError{Reason: "credentials missing"})
+		return false
+	}
+	if _, exists := state.trees[credentials.Id]; !exists {
+		loggerOf(context.Message()).Infof("No such tree with id %d", credentials.Id)
+		state.respond(context, &messages.NoSuchTreeError{Id: credentials.Id})
+		return false
+	}
+	if state.tokens[credentials.Id] != credentials.Token {
+		loggerOf(context.Message()).Warnf("Invalid credentials... treeservice denies access")
+		state.respond(context, &messages.InvalidTokenError{Credentials: credentials})
+		return false
+	}
+	state.lifetimes[credentials.Id].lastAccess = time.Now()
+	tracing.Own(context.MessageHeader(), credentials.Id)
+	return true
+}
+
+// Spawns the root of a new tree and returns its credentials.
+func (state *treeServiceActor) createTree(context actor.Context, msg *messages.CreateTreeRequest) *messages.Credentials {
+	id := state.idCounter
+	state.idCounter++
+	token := make([]byte, 4)
+	_, _ = rand.Read(token)
+
+	state.tokens[id] = fmt.Sprintf("%x", token)
+	state.trees[id] = context.Spawn(tree.NodeProps())
+	treesGauge.Set(float64(len(state.trees)))
+	state.maxSizes[id] = msg.MaxSize
+	state.lifetimes[id] = &lifetime{idleTimeout: state.config.defaultIdleTimeout, lastAccess: time.Now()}
+	if state.config.defaultTreeTTL > 0 {
+		state.lifetimes[id].expiresAt = time.Now().Add(state.config.defaultTreeTTL)
+	}
+	state.lifetimes[id].update(msg.Ttl, msg.IdleTimeout)
+	state.quotas[id] = effectiveQuota(msg.Quota, state.config.quota)
+	state.usages[id] = &treeUsage{}
+	tracing.Own(context.MessageHeader(), id)
+
+	loggerOf(context.Message()).Infof("Treeservice creates tree with id %d", id)
+	context.Send(state.trees[id], &messages.CreateTreeRequest{MaxSize: msg.MaxSize, RequestId: msg.RequestId})
+	return &messages.Credentials{Id: id, Token: state.tokens[id]}
+}
+
+// Traverses the source tree in a snapshot taken for this purpose and bulk loads the items into a new tree
+// with the same maxSize and quota. Responds with the credentials of the new tree or a QuotaExceededError if the
+// items exceed the quota or the limits of the treeservice.
+func (state *treeServiceActor) cloneTree(context actor.Context, msg *messages.CloneTreeRequest) {
+	logger := loggerOf(msg)
+	sourceID := msg.Credentials.Id
+	snapshotID := state.snapCounter
+	state.snapCounter++
+	state.snapshots[snapshotID] = &snapshot{treeID: sourceID, version: state.snapshotVersion(sourceID)}
+	state.sendActiveSnapshots(context, sourceID)
+	logger.Infof("Treeservice clones tree %d at version %d", sourceID, state.snapshots[snapshotID].version)
+
+	start := state.received
+	// Counted like forwarded requests, so a shutdown waits for the clone
+	state.shutdown.inFlight++
+	future := context.RequestFuture(state.trees[sourceID], &messages.TraverseRequest{
+		Snapshot:  &messages.Snapshot{Id: snapshotID, Version: state.snapshots[snapshotID].version},
+		RequestId: msg.RequestId,
+		Deadline:  msg.Deadline,
+	}, timeLeft(msg))
+	context.AwaitFuture(future, func(res interface{}, err error) {
+		defer func() {
+			state.shutdown.inFlight--
+			state.checkDrained(context)
+		}()
+		// The response is sent later than for other requests
+		state.received = start
+		delete(state.snapshots, snapshotID)
+		_, exists := state.trees[sourceID]
+		if exists {
+			state.sendActiveSnapshots(context, sourceID)
+		}
+		traversal, ok := res.(*messages.TraverseResponse)
+		switch {
+		case !exists:
+			logger.Infof("Treeservice can't clone tree %d, it was deleted meanwhile", sourceID)
+			state.respond(context, &messages.NoSuchTreeError{Id: sourceID})
+			return
+		case err != nil:
+			logger.Warnf("Treeservice got no traversal of tree %d for cloning: %v", sourceID, err)
+			state.respond(context, &messages.DeadlineExceededError{Deadline: msg.Deadline})
+			return
+		case !ok:
+			// Errors of the tree like OverloadedError or DeadlineExceededError reach the client unchanged
+			logger.Warnf("Treeservice failed to traverse tree %d for cloning: %s", sourceID, messages.TypeOf(res))
+			state.respond(context, res)
+			return
+		}
+		quota := state.quotas[sourceID]
+		size, longest := sizeOf(traversal.Items)
+		if !state.fitsQuota(context, sourceID, quota, &treeUsage{}, size, longest) {
+			return
+		}
+		credentials := state.createTree(context, &messages.CreateTreeRequest{
+			MaxSize:     state.maxSizes[sourceID],
+			Ttl:         msg.Ttl,
+			IdleTimeout: msg.IdleTimeout,
+			RequestId:   msg.RequestId,
+			Quota:       quota,
+		})
+		// Counted right away, so requests to other trees can't take the room meanwhile
+		state.addSizeDelta(state.trees[credentials.Id], &size)
+		context.Send(state.trees[credentials.Id], &messages.MultiInsert{Items: traversal.Items, Moved: true})
+		logger.Infof("Treeservice cloned %d items of tree %d into tree %d", len(traversal.Items), sourceID, credentials.Id)
+		state.respond(context, &messages.CloneTreeResponse{
+			Source:      msg.Credentials,
+			Credentials: credentials,
+			ItemCount:   int64(len(traversal.Items)),
+		})
+	})
+}
+
+// Poisons the tree and forgets everything belonging to it. Watchers of the tree get a NoSuchTreeError.
+func (state *treeServiceActor) deleteTree(context actor.Context, id int64) {
+	context.Poison(state.trees[id])
+	for _, watcher := range state.watchers[id] {
+		context.Unwatch(watcher)
+		context.Send(watcher, &messages.NoSuchTreeError{Id: id})
+	}
+	delete(state.watchers, id)
+	delete(state.trees, id)
+	treesGauge.Set(float64(len(state.trees)))
+	delete(state.tokens, id)
+	delete(state.maxSizes, id)
+	delete(state.lifetimes, id)
+	delete(state.versions, id)
+	delete(state.quotas, id)
+	state.total.reported = addSizes(state.total.reported, state.usages[id].reported, -1)
+	state.total.pending = addSizes(state.total.pending, state.usages[id].pending, -1)
+	delete(state.usages, id)
+	for requestID, r := range state.reservations {
+		if r.treeID == id {
+			delete(state.reservations, requestID)
+		}
+	}
+	delete(state.treeBuckets, id)
+	for txID, tx := range state.transactions {
+		if tx.treeID == id {
+			delete(state.transactions, txID)
+		}
+	}
+	for snapshotID, snap := range state.snapshots {
+		if snap.treeID == id {
+			delete(state.snapshots, snapshotID)
+		}
+	}
+	for coordinator, commit := range state.commits {
+		if commit.treeID == id {
+			delete(state.commits, coordinator)
+		}
+	}
+}
+
+// Returns the version for the next write to the tree.
+func (state *treeServiceActor) nextVersion(treeID int64) int64 {
+	state.versions[treeID]++
+	return state.versions[treeID]
+}
+
+// Returns the version a new snapshot of the tree reads: the latest one, but below the versions of commits the leafs
+// may not have applied yet. Their writes don't travel with the reads, so the snapshot could see them later.
+func (state *treeServiceActor) snapshotVersion(treeID int64) int64 {
+	version := state.versions[treeID]
+	for _, commit := range state.commits {
+		if commit.treeID == treeID && commit.version != 0 && commit.version <= version {
+			version = commit.version - 1
+		}
+	}
+	return version
+}
+
+// Fills in the version of the snapshot to read from. Responds with an error if the snapshot doesn't exist.
+func (state *treeServiceActor) resolveSnapshot(context actor.Context, treeID int64, snapshot *messages.Snapshot) bool {
+	if snapshot == nil {
+		return true
+	}
+	snap, exists := state.snapshots[snapshot.Id]
+	if !exists || snap.treeID != treeID {
+		loggerOf(context.Message()).Infof("No such snapshot with id %d for tree %d", snapshot.Id, treeID)
+		state.respond(context, &messages.NoSuchSnapshotError{SnapshotId: snapshot.Id})
+		return false
+	}
+	snapshot.Version = snap.version
+	return true
+}
+
+// Tells the leafs of the tree which versions are still visible in snapshots.
+func (state *treeServiceActor) sendActiveSnapshots(context actor.Context, treeID int64) {
+	versions := make([]int64, 0)
+	for _, snap := range state.snapshots {
+		if snap.treeID == treeID {
+			versions = append(versions, snap.version)
+		}
+	}
+	context.Send(state.trees[treeID], &messages.ActiveSnapshots{
+		Versions:  versions,
+		RequestId: messages.RequestIDOf(context.Message()),
+	})
+}
+
+// Returns the transaction with the given id if it belongs to the given tree. Responds with an error otherwise.
+func (state *treeServiceActor) transaction(context actor.Context, treeID, txID int64) (*transaction, bool) {
+	tx, exists := state.transactions[txID]
+	if !exists || tx.treeID != treeID {
+		loggerOf(context.Message()).Infof("No such transaction with id %d for tree %d", txID, treeID)
+		state.respond(context, &messages.NoSuchTxError{TxId: txID})
+		return nil, false
+	}
+	return tx, true
+}
+
+// Responds to the current request and records it in the metrics.
+func (state *treeServiceActor) respond(context actor.Context, response interface{}) {
+	observeRequest(context.Message(), response, state.received)
+	context.Respond(response)
+}
+
+// Forwards the current request and relays the response to the sender once it arrives, recording it in the metrics.
+// Without response until the deadline of the request the sender gets a DeadlineExceededError.
+// Changes to a watched tree are passed back to the treeservice for its watchers, as are responses to requests with
+// idempotency key.
+func (state *treeServiceActor) forward(context actor.Context, pid *actor.PID, treeID int64) {
+	request, sender, start := context.Message(), context.Sender(), state.received
+	logger := loggerOf(request)
+	self, watched := context.Self(), len(state.watchers[treeID]) > 0
+	key := idempotencyKeyOf(treeID, request)
+	if key != "" {
+		state.keepResult(key, request)
+	}
+	state.shutdown.inFlight++
+	reserved, _ := state.requestedSize(request)
+	state.reserve(treeID, messages.RequestIDOf(request), reserved)
+	future := context.RequestFuture(pid, request, timeLeft(request))
+	go func() {
+		res, err := future.Result()
+		if err != nil {
+			logger.Warnf("Treeservice got no response to %s from %s: %v", messages.TypeOf(request), pid.Id, err)
+			res = &messages.DeadlineExceededError{Deadline: request.(messages.Deadlined).GetDeadline()}
+		}
+		observeRequest(request, res, start)
+		if sender != nil {
+			actor.EmptyRootContext.Send(sender, res)
+		}
+		if events := watchEventsOf(request, res); watched && len(events) > 0 {
+			actor.EmptyRootContext.Send(self, &watchNotification{treeID: treeID, events: events})
+		}
+		if key != "" {
+			actor.EmptyRootContext.Send(self, &idempotentResponse{key: key, response: res})
+		}
+		if reserved.Items != 0 || reserved.Bytes != 0 {
+			actor.EmptyRootContext.Send(self, &reservationDone{
+				requestID: messages.RequestIDOf(request),
+				reserved:  reserved,
+				rejected:  rejected(res),
+			})
+		}
+		actor.EmptyRootContext.Send(self, &forwardDone{})
+	}()
+}
+
+// Removes the watcher from all trees.
+func (state *treeServiceActor) removeWatcher(watcher *actor.PID) {
+	for id, watchers := range state.watchers {
+		for i, pid := range watchers {
+			if pid.Equal(watcher) {
+				state.watchers[id] = append(watchers[:i], watchers[i+1:]...)
+				break
+			}
+		}
+		if len(state.watchers[id]) == 0 {
+			delete(state.watchers, id)
+		}
+	}
+}
+
+// Prefixes the names of the spans of the treeservice.
+func (state *treeServiceActor) Kind() string {
+	return "treeservice"
 }
 
 func (state *treeServiceActor) Receive(context actor.Context) {
+	state.received = time.Now()
+	assignRequestID(context.Message())
+	assignDeadline(context.Message(), state.received.Add(defaultTimeout))
+	logger := loggerOf(context.Message())
+	if msg, ok := context.Message().(messages.Deadlined); ok && messages.PastDeadline(msg) {
+		logger.Infof("Treeservice drops %s past its deadline", messages.TypeOf(msg))
+		state.respond(context, &messages.DeadlineExceededError{Deadline: msg.GetDeadline()})
+		return
+	}
+	if state.rejectedWhileDraining(context) || !state.wellFormed(context) || !state.withinRateLimits(context) {
+		return
+	}
 	switch msg := context.Message().(type) {
+	case *actor.Started:
+		state.stopPurging = make(chan struct{})
+		go schedulePurge(context.Self(), state.config.purgeInterval, state.stopPurging)
+	case *actor.Stopping:
+		close(state.stopPurging)
+	case *purgeTick:
+		now := time.Now()
+		for id, lifetime := range state.lifetimes {
+			if expired, reason := lifetime.expired(now); expired {
+				logger.Infof("Audit: treeservice deletes tree %d, %s", id, reason)
+				state.deleteTree(context, id)
+			}
+		}
+		for id, root := range state.trees {
+			context.Send(root, &messages.PurgeExpired{
+				Version:   state.nextVersion(id),
+				Now:       now.UnixNano() / int64(time.Millisecond),
+				RequestId: newRequestID(),
+			})
+		}
+		for txID, tx := range state.transactions {
+			if state.config.txIdleTimeout > 0 && now.Sub(tx.lastAccess) >= state.config.txIdleTimeout {
+				logger.Infof("Treeservice discards transaction %d on tree %d, idle since %s",
+					txID,
+					tx.treeID,
+					tx.lastAccess.Format(time.RFC3339),
+				)
+				delete(state.transactions, txID)
+			}
+		}
+		state.purgeResults(now)
+		state.purgeBuckets(now)
+	case *idempotentResponse:
+		state.storeResult(context, msg)
+	case *reservationDone:
+		state.reservationDone(context, msg)
+	case *reservationSettled:
+		state.settle(msg.requestID)
+	case *messages.SizeDelta:
+		state.addSizeDelta(context.Sender(), msg)
+	case *drainRequest:
+		logger.Infof("Treeservice stops accepting requests, %d in flight", state.shutdown.inFlight)
+		state.shutdown.draining = true
+		state.shutdown.drainWaiter = context.Sender()
+		state.checkDrained(context)
+	case *forwardDone:
+		state.shutdown.inFlight--
+		state.checkDrained(context)
+	case *stopTreesRequest:
+		state.stopTrees(context)
+	case *watchNotification:
+		for _, watcher := range state.watchers[msg.treeID] {
+			for _, event := range msg.events {
+				context.Send(watcher, event)
+			}
+		}
+	case *actor.Terminated:
+		delete(state.commits, msg.Who.Id)
+		state.removeWatcher(msg.Who)
+		state.treeTerminated(context, msg.Who)
+	case *messages.WatchRequest:
+		if state.authorized(context, msg.Credentials) && context.Sender() != nil {
+			logger.Infof("Treeservice registers %s as watcher of tree %d", context.Sender().Id, msg.Credentials.Id)
+			state.watchers[msg.Credentials.Id] = append(state.watchers[msg.Credentials.Id], context.Sender())
+			context.Watch(context.Sender())
+		}
 	case *messages.CreateTreeRequest:
-		id := state.idCounter
-		state.idCounter++
-		token := make([]byte, 4)
-		_, _ = rand.Read(token)
-
-		state.tokens[id] = fmt.Sprintf("%x", token)
-		state.trees[id] = context.Spawn(actor.PropsFromProducer(tree.NodeActorProducer))
-
-		log.Printf("Treeservice creates tree with id %d", id)
-		context.Send(state.trees[id], &messages.CreateTreeRequest{MaxSize: msg.MaxSize})
-		context.Respond(
-			&messages.CreateTreeResponse{Credentials: &messages.Credentials{Id: id, Token: state.tokens[id]}},
-		)
-	case *messages.SearchRequest:
-		if _, exists := state.trees[msg.Credentials.Id]; !exists {
-			log.Printf("No such tree with id %d", msg.Credentials.Id)
-			context.Respond(&messages.NoSuchTreeError{Id: msg.Credentials.Id})
-			return
+		credentials := state.createTree(context, msg)
+		state.respond(context, &messages.CreateTreeResponse{Credentials: credentials})
+	case *messages.CloneTreeRequest:
+		if state.authorized(context, msg.Credentials) {
+			state.cloneTree(context, msg)
 		}
-		if state.tokens[msg.Credentials.Id] != msg.Credentials.Token {
-			log.Printf("Invalid credentials... treeservice denies access")
-			context.Respond(&messages.InvalidTokenError{Credentials: msg.Credentials})
-		} else {
-			log.Printf(
+	case *messages.SearchRequest:
+		if state.authorized(context, msg.Credentials) && state.resolveSnapshot(context, msg.Credentials.Id, msg.Snapshot) {
+			logger.Debugf(
 				"Valid credentials... treeservice forwards searchrequest to %s",
 				state.trees[msg.Credentials.Id].Id,
 			)
-			context.Forward(state.trees[msg.Credentials.Id])
+			state.forward(context, state.trees[msg.Credentials.Id], msg.Credentials.Id)
 		}
 	case *messages.DeleteRequest:
-		if _, exists := state.trees[msg.Credentials.Id]; !exists {
-			log.Printf("No such tree with id %d", msg.Credentials.Id)
-			context.Respond(&messages.NoSuchTreeError{Id: msg.Credentials.Id})
-			return
-		}
-		if state.tokens[msg.Credentials.Id] != msg.Credentials.Token {
-			log.Printf("Invalid credentials... treeservice denies access")
-			context.Respond(&messages.InvalidTokenError{Credentials: msg.Credentials})
-		} else {
-			log.Printf(
+		if state.authorized(context, msg.Credentials) && !state.replayed(context, msg.Credentials.Id) {
+			msg.Version = state.nextVersion(msg.Credentials.Id)
+			logger.Debugf(
 				"Valid credentials... treeservice forwards deleterequest to %s",
 				state.trees[msg.Credentials.Id].Id,
 			)
-			context.Forward(state.trees[msg.Credentials.Id])
+			state.forward(context, state.trees[msg.Credentials.Id], msg.Credentials.Id)
 		}
 	case *messages.InsertRequest:
-		if _, exists := state.trees[msg.Credentials.Id]; !exists {
-			log.Printf("No such tree with id %d", msg.Credentials.Id)
-			context.Respond(&messages.NoSuchTreeError{Id: msg.Credentials.Id})
-			return
-		}
-		if state.tokens[msg.Credentials.Id] != msg.Credentials.Token {
-			log.Printf("Invalid credentials... treeservice denies access")
-			context.Respond(&messages.InvalidTokenError{Credentials: msg.Credentials})
-		} else {
-			log.Printf(
+		if state.authorized(context, msg.Credentials) && !state.replayed(context, msg.Credentials.Id) &&
+			state.withinQuota(context, msg.Credentials.Id) {
+			msg.Version = state.nextVersion(msg.Credentials.Id)
+			if msg.Ttl > 0 {
+				msg.ExpiresAt = time.Now().Add(time.Duration(msg.Ttl)*time.Millisecond).UnixNano() / int64(time.Millisecond)
+			}
+			logger.Debugf(
 				"Valid credentials... treeservice forwards insertrequest to %s",
 				state.trees[msg.Credentials.Id].Id,
 			)
-			context.Forward(state.trees[msg.Credentials.Id])
+			state.forward(context, state.trees[msg.Credentials.Id], msg.Credentials.Id)
 		}
 	case *messages.TraverseRequest:
-		if _, exists := state.trees[msg.Credentials.Id]; !exists {
-			log.Printf("No such tree with id %d", msg.Credentials.Id)
-			context.Respond(&messages.NoSuchTreeError{Id: msg.Credentials.Id})
-			return
-		}
-		if state.tokens[msg.Credentials.Id] != msg.Credentials.Token {
-			log.Printf("Invalid credentials... treeservice denies access.")
-			context.Respond(&messages.InvalidTokenError{Credentials: msg.Credentials})
-		} else {
-			log.Printf(
+		if state.authorized(context, msg.Credentials) && state.resolveSnapshot(context, msg.Credentials.Id, msg.Snapshot) &&
+			state.traversable(context, msg.Credentials.Id) {
+			logger.Debugf(
 				"Valid credentials... treeservice forwards traverserequest to %s",
 				state.trees[msg.Credentials.Id].Id,
 			)
-			context.Forward(state.trees[msg.Credentials.Id])
+			state.forward(context, state.trees[msg.Credentials.Id], msg.Credentials.Id)
+		}
+	case *messages.RangeRequest:
+		if state.authorized(context, msg.Credentials) && state.resolveSnapshot(context, msg.Credentials.Id, msg.Snapshot) {
+			logger.Debugf(
+				"Valid credentials... treeservice forwards rangerequest to %s",
+				state.trees[msg.Credentials.Id].Id,
+			)
+			state.forward(context, state.trees[msg.Credentials.Id], msg.Credentials.Id)
+		}
+	case *messages.SnapshotRequest:
+		if state.authorized(context, msg.Credentials) {
+			id := state.snapCounter
+			state.snapCounter++
+			state.snapshots[id] = &snapshot{treeID: msg.Credentials.Id, version: state.snapshotVersion(msg.Credentials.Id)}
+			logger.Infof("Treeservice creates snapshot %d of tree %d at version %d",
+				id,
+				msg.Credentials.Id,
+				state.snapshots[id].version,
+			)
+			state.sendActiveSnapshots(context, msg.Credentials.Id)
+			state.respond(context, &messages.SnapshotResponse{Credentials: msg.Credentials, SnapshotId: id})
+		}
+	case *messages.ReleaseSnapshotRequest:
+		if !state.authorized(context, msg.Credentials) {
+			return
+		}
+		if snap, exists := state.snapshots[msg.SnapshotId]; !exists || snap.treeID != msg.Credentials.Id {
+			logger.Infof("No such snapshot with id %d for tree %d", msg.SnapshotId, msg.Credentials.Id)
+			state.respond(context, &messages.NoSuchSnapshotError{SnapshotId: msg.SnapshotId})
+			return
 		}
+		delete(state.snapshots, msg.SnapshotId)
+		logger.Infof("Treeservice releases snapshot %d of tree %d", msg.SnapshotId, msg.Credentials.Id)
+		state.sendActiveSnapshots(context, msg.Credentials.Id)
+		state.respond(context, &messages.ReleaseSnapshotResponse{SnapshotId: msg.SnapshotId})
 	case *messages.DeleteTreeRequest:
-		if _, exists := state.trees[msg.Credentials.Id]; !exists {
-			log.Printf("No such tree with id %d", msg.Credentials.Id)
-			context.Respond(&messages.NoSuchTreeError{Id: msg.Credentials.Id})
+		if state.authorized(context, msg.Credentials) {
+			logger.Infof("Valid credentials... Poisoning tree %d and deleting its data", msg.Credentials.Id)
+			state.deleteTree(context, msg.Credentials.Id)
+			state.respond(context, &messages.DeleteTreeResponse{Credentials: msg.Credentials})
+		}
+	case *messages.InsertBatchRequest:
+		if state.authorized(context, msg.Credentials) && !state.replayed(context, msg.Credentials.Id) &&
+			state.withinQuota(context, msg.Credentials.Id) {
+			msg.Version = state.nextVersion(msg.Credentials.Id)
+			logger.Debugf(
+				"Valid credentials... treeservice forwards batch of %d items to %s",
+				len(msg.Items),
+				state.trees[msg.Credentials.Id].Id,
+			)
+			state.forward(context, state.trees[msg.Credentials.Id], msg.Credentials.Id)
+		}
+	case *messages.TreeInfoRequest:
+		if state.authorized(context, msg.Credentials) {
+			lifetime := state.lifetimes[msg.Credentials.Id]
+			state.respond(context, &messages.TreeInfoResponse{
+				Credentials: msg.Credentials,
+				MaxSize:     state.maxSizes[msg.Credentials.Id],
+				ExpiresAt:   lifetime.expiresAtMillis(),
+				IdleTimeout: int64(lifetime.idleTimeout / time.Millisecond),
+				Quota:       state.quotas[msg.Credentials.Id],
+				Items:       state.usages[msg.Credentials.Id].reported.Items,
+				Bytes:       state.usages[msg.Credentials.Id].reported.Bytes,
+			})
+		}
+	case *messages.SetTreeExpiryRequest:
+		if state.authorized(context, msg.Credentials) {
+			lifetime := state.lifetimes[msg.Credentials.Id]
+			lifetime.update(msg.Ttl, msg.IdleTimeout)
+			logger.Infof("Treeservice sets expiry of tree %d to %s and idle timeout to %s",
+				msg.Credentials.Id,
+				lifetime.expiresAt,
+				lifetime.idleTimeout,
+			)
+			state.respond(context, &messages.SetTreeExpiryResponse{
+				Credentials: msg.Credentials,
+				ExpiresAt:   lifetime.expiresAtMillis(),
+				IdleTimeout: int64(lifetime.idleTimeout / time.Millisecond),
+			})
+		}
+	case *messages.BeginTxRequest:
+		if state.authorized(context, msg.Credentials) {
+			txID := state.txCounter
+			state.txCounter++
+			state.transactions[txID] = &transaction{treeID: msg.Credentials.Id, lastAccess: state.received}
+			logger.Infof("Treeservice begins transaction %d on tree %d", txID, msg.Credentials.Id)
+			state.respond(context, &messages.BeginTxResponse{Credentials: msg.Credentials, TxId: txID})
+		}
+	case *messages.StageTxRequest:
+		if !state.authorized(context, msg.Credentials) || !state.withinQuota(context, msg.Credentials.Id) {
+			return
+		}
+		if tx, ok := state.transaction(context, msg.Credentials.Id, msg.TxId); ok {
+			tx.stage(msg.Operation)
+			tx.lastAccess = state.received
+			logger.Debugf("Treeservice stages %s of key %d in transaction %d",
+				msg.Operation.Type,
+				msg.Operation.Item.Key,
+				msg.TxId,
+			)
+			state.respond(context, &messages.StageTxResponse{TxId: msg.TxId, Operation: msg.Operation})
+		}
+	case *messages.CommitTxRequest:
+		if !state.authorized(context, msg.Credentials) || state.replayed(context, msg.Credentials.Id) {
 			return
 		}
-		if state.tokens[msg.Credentials.Id] != msg.Credentials.Token {
-			log.Printf("Invalid credentials... treeservice denies access")
-			context.Respond(&messages.InvalidTokenError{Credentials: msg.Credentials})
-		} else {
-			log.Printf("Valid credentials... Poisoning tree %d and deleting its data", msg.Credentials.Id)
-			context.Poison(state.trees[msg.Credentials.Id])
-			delete(state.trees, msg.Credentials.Id)
-			delete(state.tokens, msg.Credentials.Id)
-			context.Respond(&messages.DeleteTreeResponse{Credentials: msg.Credentials})
+		tx, ok := state.transaction(context, msg.Credentials.Id, msg.TxId)
+		if !ok || !state.withinQuota(context, msg.Credentials.Id) {
+			return
+		}
+		coordinator := context.Spawn(tracing.Traced(actor.PropsFromProducer(tree.TxCoordinatorProducer(
+			state.trees[msg.Credentials.Id],
+			msg.TxId,
+			tx.operations,
+			state.config.txTimeout,
+		))))
+		state.commits[coordinator.Id] = &pendingCommit{treeID: msg.Credentials.Id}
+		logger.Debugf("Treeservice hands transaction %d over to coordinator %s", msg.TxId, coordinator.Id)
+		// The transaction is still needed for reserving room in the quota
+		state.forward(context, coordinator, msg.Credentials.Id)
+		delete(state.transactions, msg.TxId)
+	case *messages.TxVersionRequest:
+		if commit, exists := state.commits[context.Sender().GetId()]; exists {
+			commit.version = state.nextVersion(commit.treeID)
+			logger.Debugf("Treeservice commits transaction %d in version %d", msg.TxId, commit.version)
+			context.Respond(&messages.TxVersionResponse{TxId: msg.TxId, Version: commit.version})
+		}
+	case *messages.AbortTxRequest:
+		if !state.authorized(context, msg.Credentials) {
+			return
+		}
+		if _, ok := state.transaction(context, msg.Credentials.Id, msg.TxId); ok {
+			delete(state.transactions, msg.TxId)
+			logger.Infof("Treeservice discards transaction %d", msg.TxId)
+			state.respond(context, &messages.AbortTxResponse{TxId: msg.TxId})
+		}
+	case *messages.TraceRequest:
+		if state.authorized(context, msg.Credentials) {
+			state.respond(context, &messages.TraceResponse{Spans: tracing.Spans(msg.TraceId, msg.Credentials.Id)})
 		}
 	}
 }
 
-func newTreeServiceActor() actor.Actor {
-	myActor := treeServiceActor{}
-	myActor.idCounter = 1
-	myActor.tokens = make(map[int64]string)
-	myActor.trees = make(map[int64]*actor.PID)
-	return &myActor
+// Sends a purgeTick to the treeservice in every interval until stop is closed.
+func schedulePurge(treeService *actor.PID, interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			actor.EmptyRootContext.Send(treeService, &purgeTick{})
+		case <-stop:
+			return
+		}
+	}
+}
+
+func newTreeServiceActor(config serviceConfig) actor.Producer {
+	return func() actor.Actor {
+		myActor := treeServiceActor{}
+		myActor.config = config
+		myActor.idCounter = 1
+		myActor.txCounter = 1
+		myActor.snapCounter = 1
+		myActor.tokens = make(map[int64]string)
+		myActor.trees = make(map[int64]*actor.PID)
+		myActor.maxSizes = make(map[int64]int64)
+		myActor.lifetimes = make(map[int64]*lifetime)
+		myActor.transactions = make(map[int64]*transaction)
+		myActor.commits = make(map[string]*pendingCommit)
+		myActor.versions = make(map[int64]int64)
+		myActor.snapshots = make(map[int64]*snapshot)
+		myActor.watchers = make(map[int64][]*actor.PID)
+		myActor.results = make(map[string]*idempotentResult)
+		myActor.quotas = make(map[int64]*messages.Quota)
+		myActor.usages = make(map[int64]*treeUsage)
+		myActor.reservations = make(map[string]*reservation)
+		myActor.treeBuckets = make(map[int64]*tokenBucket)
+		myActor.clientBuckets = make(map[string]*tokenBucket)
+		return &myActor
+	}
 }
 
 func main() {
@@ -143,13 +754,202 @@ func main() {
 			Usage: "the treeservice will listen on this address",
 			Value: "localhost:8090",
 		},
+		cli.DurationFlag{
+			Name:  "tx-timeout",
+			Usage: "commits are aborted if not all involved leafs voted within this duration, given up if not all acknowledged",
+			Value: 5 * time.Second,
+		},
+		cli.DurationFlag{
+			Name:  "tx-idle-timeout",
+			Usage: "transactions are discarded if neither staged to, committed nor aborted for this duration, 0 to keep them",
+			Value: 10 * time.Minute,
+		},
+		cli.DurationFlag{
+			Name:  "purge-interval",
+			Usage: "expired items and trees are deleted in this interval",
+			Value: 10 * time.Second,
+		},
+		cli.DurationFlag{
+			Name:  "tree-ttl",
+			Usage: "trees created without ttl are deleted after this duration, 0 to keep them",
+		},
+		cli.DurationFlag{
+			Name:  "idempotency-ttl",
+			Usage: "responses to requests with idempotency key are repeated for retries within this duration",
+			Value: 10 * time.Minute,
+		},
+		cli.IntFlag{
+			Name:  "idempotency-max-keys",
+			Usage: "responses kept for requests with idempotency key at most, the oldest is forgotten first, 0 for no limit",
+			Value: 100000,
+		},
+		cli.Int64Flag{
+			Name:  "max-items",
+			Usage: "maximum number of items in all trees together, 0 for no limit",
+		},
+		cli.Int64Flag{
+			Name:  "max-traverse-items",
+			Usage: "traversals of trees with more items are rejected, as they are answered at once, 0 for no limit",
+			Value: 100000,
+		},
+		cli.Int64Flag{
+			Name:  "max-value-bytes",
+			Usage: "maximum length of a value in bytes, 0 for no limit",
+		},
+		cli.Int64Flag{
+			Name:  "max-total-bytes",
+			Usage: "maximum total length of the values in all trees together in bytes, 0 for no limit",
+		},
+		cli.Float64Flag{
+			Name:  "tree-rate",
+			Usage: "requests per second accepted for each tree, 0 for no limit",
+		},
+		cli.IntFlag{
+			Name:  "tree-burst",
+			Usage: "requests accepted at once for each tree before --tree-rate applies",
+			Value: 100,
+		},
+		cli.Float64Flag{
+			Name:  "client-rate",
+			Usage: "requests per second accepted from each client address, 0 for no limit",
+		},
+		cli.IntFlag{
+			Name:  "client-burst",
+			Usage: "requests accepted at once from each client address before --client-rate applies",
+			Value: 50,
+		},
+		cli.IntFlag{
+			Name:  "node-mailbox-size",
+			Usage: "messages the mailbox of a node holds before it rejects requests with OverloadedError, 0 for no limit",
+			Value: 1000,
+		},
+		cli.Float64Flag{
+			Name:  "hot-leaf-rate",
+			Usage: "requests per second above which a leaf splits even if it holds no more than maxSize items, 0 to never",
+			Value: 1000,
+		},
+		cli.DurationFlag{
+			Name:  "shutdown-grace",
+			Usage: "time the treeservice has after SIGINT or SIGTERM to answer requests in flight and stop the trees",
+			Value: 30 * time.Second,
+		},
+		cli.StringFlag{
+			Name:  "http",
+			Usage: "address of the REST gateway, e.g. localhost:8080, empty to disable it",
+		},
+		cli.StringFlag{
+			Name:  "metrics",
+			Usage: "address of the http endpoint serving /metrics, empty to disable it",
+			Value: "localhost:9090",
+		},
+		cli.DurationFlag{
+			Name:  "tree-idle-timeout",
+			Usage: "trees created without idle timeout are deleted if not accessed for this duration, 0 to keep them",
+		},
+		cli.StringFlag{
+			Name:  "grpc",
+			Usage: "address of the gRPC TreeService, e.g. localhost:8070, empty to disable it",
+		},
+		cli.StringFlag{
+			Name:  "log-level",
+			Usage: "only log messages of this level or above: debug, info, warning or error",
+			Value: "info",
+		},
+		cli.StringFlag{
+			Name:  "log-format",
+			Usage: "format of the log output: text or json",
+			Value: "text",
+		},
+		cli.Float64Flag{
+			Name:  "trace-ratio",
+			Usage: "fraction of the requests traced although the client didn't start a trace, e.g. 0.01",
+		},
+		cli.StringFlag{
+			Name:  "trace-file",
+			Usage: "file the spans are appended to in OTLP/JSON format, empty to not write them",
+		},
+		cli.StringFlag{
+			Name:  "trace-endpoint",
+			Usage: "OTLP/HTTP collector the spans are sent to, e.g. http://localhost:4318, empty to not send them",
+		},
+		cli.StringFlag{
+			Name:  "tls-cert",
+			Usage: "certificate of the treeservice in PEM format, enables TLS for the remote traffic",
+		},
+		cli.StringFlag{
+			Name:  "tls-key",
+			Usage: "private key of the certificate in PEM format",
+		},
+		cli.StringFlag{
+			Name:  "tls-ca",
+			Usage: "CA in PEM format verifying the certificates of peers, default: the system's CAs",
+		},
+		cli.BoolFlag{
+			Name:  "tls-client-auth",
+			Usage: "only accept connections from peers with a certificate signed by --tls-ca",
+		},
 	}
 	app.Action = func(c *cli.Context) error {
-		var wg sync.WaitGroup
-		wg.Add(1)
-		remote.Register("treeservice", actor.PropsFromProducer(newTreeServiceActor))
-		remote.Start(c.String("bind"))
-		wg.Wait()
+		if err := configureLogging(c.String("log-level"), c.String("log-format")); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if path := c.String("trace-file"); path != "" {
+			if err := tracing.ExportToFile(path); err != nil {
+				return cli.NewExitError(err, 1)
+			}
+		}
+		if endpoint := c.String("trace-endpoint"); endpoint != "" {
+			tracing.ExportToCollector(endpoint)
+		}
+		tree.LimitMailboxes(c.Int("node-mailbox-size"))
+		tree.SplitHotLeafs(c.Float64("hot-leaf-rate"))
+		if address := c.String("metrics"); address != "" {
+			go serveMetrics(address)
+		}
+		props := actor.PropsFromProducer(newTreeServiceActor(serviceConfig{
+			txTimeout:          c.Duration("tx-timeout"),
+			txIdleTimeout:      c.Duration("tx-idle-timeout"),
+			purgeInterval:      c.Duration("purge-interval"),
+			defaultTreeTTL:     c.Duration("tree-ttl"),
+			defaultIdleTimeout: c.Duration("tree-idle-timeout"),
+			idempotencyTTL:     c.Duration("idempotency-ttl"),
+			maxResults:         c.Int("idempotency-max-keys"),
+			maxTraverseItems:   c.Int64("max-traverse-items"),
+			quota: &messages.Quota{
+				MaxItems:      c.Int64("max-items"),
+				MaxValueBytes: c.Int64("max-value-bytes"),
+				MaxTotalBytes: c.Int64("max-total-bytes"),
+			},
+			treeLimit:   rateLimit{rate: c.Float64("tree-rate"), burst: c.Int("tree-burst")},
+			clientLimit: rateLimit{rate: c.Float64("client-rate"), burst: c.Int("client-burst")},
+		})).WithMailbox(tree.MeasuredMailbox("treeservice"))
+		// The sampler comes first so the traces it starts are recorded
+		props = tracing.Traced(props.WithReceiverMiddleware(tracing.Sampler(c.Float64("trace-ratio"))))
+		options, err := remotetls.Config{
+			CertFile:   c.String("tls-cert"),
+			KeyFile:    c.String("tls-key"),
+			CAFile:     c.String("tls-ca"),
+			ClientAuth: c.Bool("tls-client-auth"),
+		}.Options()
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		remote.Register("treeservice", props)
+		remote.Start(c.String("bind"), options...)
+		// Spawned up front so the REST gateway and remote clients share the same actor
+		service, err := actor.EmptyRootContext.SpawnNamed(props, serviceActorName)
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		var gateway *http.Server
+		if address := c.String("http"); address != "" {
+			gateway = serveGateway(address, service)
+		}
+		var rpc *grpc.Server
+		if address := c.String("grpc"); address != "" {
+			rpc = serveGRPC(address, service)
+		}
+		shutdownOnSignal(service, gateway, rpc, c.Duration("shutdown-grace"))
 		return nil
 	}
 	_ = app.Run(os.Args)
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "treeservice_requests_total",
+		Help: "Number of requests answered by the treeservice by type of request and result.",
+	}, []string{"type", "result"})
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "treeservice_request_duration_seconds",
+		Help:    "Time from receiving a request until its response by type of request and result.",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 4, 10),
+	}, []string{"type", "result"})
+	treesGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "treeservice_trees",
+		Help: "Number of trees managed by the treeservice.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(requestsTotal, requestDuration, treesGauge)
+}
+
+// Returns success for responses and the name of the error otherwise.
+func resultOf(response interface{}) string {
+	if name := messages.TypeOf(response); strings.HasSuffix(name, "Error") {
+		return name
+	}
+	return "success"
+}
+
+// Records a request answered with the response which arrived after start.
+func observeRequest(request, response interface{}, start time.Time) {
+	labels := prometheus.Labels{"type": messages.TypeOf(request), "result": resultOf(response)}
+	requestsTotal.With(labels).Inc()
+	requestDuration.With(labels).Observe(time.Since(start).Seconds())
+}
+
+// Serves the metrics on http://address/metrics.
+func serveMetrics(address string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	logrus.Infof("Treeservice serves metrics on http://%s/metrics", address)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		logrus.Errorf("Metrics endpoint stopped: %v", err)
+	}
+}
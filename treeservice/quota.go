@@ -0,0 +1,288 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Items and bytes of a tree as its root reported them, and those which forwarded inserts without response yet
+// may add. Both count against the quota, so concurrent inserts can't exceed it together.
+type treeUsage struct {
+	reported, pending messages.SizeDelta
+}
+
+// How long a reservation outlives the response to its request, so the size changes the root reports after the
+// response still find it.
+const reservationSettle = time.Second
+
+// Room which forwarded requests with the same request id reserved in the quota of a tree. The size changes the root
+// reports for the request release it, the remaining room is released once the request got rejected or the
+// reservation settled after the response.
+type reservation struct {
+	treeID      int64
+	remaining   messages.SizeDelta
+	inFlight    int
+	respondedAt time.Time
+}
+
+// Tells the treeservice that a forwarded request which reserved room in the quota of the tree got its response.
+// Rejected requests changed nothing, so their room is released at once.
+type reservationDone struct {
+	requestID string
+	reserved  messages.SizeDelta
+	rejected  bool
+}
+
+// Tells the treeservice to release what is left of a reservation after the response to its request.
+type reservationSettled struct {
+	requestID string
+}
+
+// Returns the quota of a new tree: its own limits and the maximum length of a value of the treeservice if that is
+// lower. The maximum items and bytes of the treeservice apply to all trees together instead.
+func effectiveQuota(requested, global *messages.Quota) *messages.Quota {
+	return &messages.Quota{
+		MaxItems:      requested.GetMaxItems(),
+		MaxValueBytes: lowerLimit(requested.GetMaxValueBytes(), global.MaxValueBytes),
+		MaxTotalBytes: requested.GetMaxTotalBytes(),
+	}
+}
+
+// Returns the lower of two limits where 0 means no limit.
+func lowerLimit(a, b int64) int64 {
+	if a == 0 || b != 0 && b < a {
+		return b
+	}
+	return a
+}
+
+// Returns the items and bytes the request adds to a tree at most and the length of its longest value.
+func (state *treeServiceActor) requestedSize(message interface{}) (messages.SizeDelta, int64) {
+	size, longest := messages.SizeDelta{}, int64(0)
+	add := func(value string) {
+		size.Items++
+		size.Bytes += int64(len(value))
+		if int64(len(value)) > longest {
+			longest = int64(len(value))
+		}
+	}
+	// The getters return empty values for missing fields, so incomplete requests don't take any room
+	switch msg := message.(type) {
+	case *messages.InsertRequest:
+		add(msg.Item.GetValue())
+	case *messages.InsertBatchRequest:
+		for _, item := range msg.Items {
+			add(item.GetValue())
+		}
+	case *messages.StageTxRequest:
+		// Only the length of the value is checked, staged operations don't take room until they are committed
+		if msg.Operation.GetType() != messages.DELETE {
+			longest = int64(len(msg.Operation.GetItem().GetValue()))
+		}
+	case *messages.CommitTxRequest:
+		// Updates count with their whole value and deletes don't free room, the values they replace aren't known
+		if tx, exists := state.transactions[msg.TxId]; exists {
+			for _, operation := range tx.operations {
+				switch operation.Type {
+				case messages.INSERT:
+					add(operation.Item.Value)
+				case messages.UPDATE:
+					size.Bytes += int64(len(operation.Item.Value))
+				}
+			}
+		}
+	}
+	return size, longest
+}
+
+// Returns the number and bytes of the items and the length of their longest value.
+func sizeOf(items []*messages.Item) (messages.SizeDelta, int64) {
+	size, longest := messages.SizeDelta{Items: int64(len(items))}, int64(0)
+	for _, item := range items {
+		size.Bytes += int64(len(item.Value))
+		if int64(len(item.Value)) > longest {
+			longest = int64(len(item.Value))
+		}
+	}
+	return size, longest
+}
+
+// Returns the error for the first limit of the quota which adding size to the usage would exceed, nil if it fits.
+func quotaError(
+	scope string, quota *messages.Quota, usage *treeUsage, size messages.SizeDelta, longest int64,
+) *messages.QuotaExceededError {
+	items := usage.reported.Items + usage.pending.Items + size.Items
+	bytes := usage.reported.Bytes + usage.pending.Bytes + size.Bytes
+	switch {
+	case quota.MaxValueBytes > 0 && longest > quota.MaxValueBytes:
+		return &messages.QuotaExceededError{
+			Scope:     scope,
+			Limit:     "maxValueBytes",
+			Max:       quota.MaxValueBytes,
+			Requested: longest,
+		}
+	case quota.MaxItems > 0 && size.Items > 0 && items > quota.MaxItems:
+		return &messages.QuotaExceededError{Scope: scope, Limit: "maxItems", Max: quota.MaxItems, Requested: items}
+	case quota.MaxTotalBytes > 0 && size.Bytes > 0 && bytes > quota.MaxTotalBytes:
+		return &messages.QuotaExceededError{
+			Scope:     scope,
+			Limit:     "maxTotalBytes",
+			Max:       quota.MaxTotalBytes,
+			Requested: bytes,
+		}
+	}
+	return nil
+}
+
+// Responds with a QuotaExceededError and returns false if the current request could exceed the quota of the tree
+// or the limits of the treeservice.
+func (state *treeServiceActor) withinQuota(context actor.Context, treeID int64) bool {
+	size, longest := state.requestedSize(context.Message())
+	return state.fitsQuota(context, treeID, state.quotas[treeID], state.usages[treeID], size, longest)
+}
+
+// Responds with a QuotaExceededError and returns false if adding size to the usage of the tree with the given
+// quota exceeds it or the total items and bytes of all trees exceed the limits of the treeservice.
+func (state *treeServiceActor) fitsQuota(
+	context actor.Context,
+	treeID int64,
+	quota *messages.Quota,
+	usage *treeUsage,
+	size messages.SizeDelta,
+	longest int64,
+) bool {
+	exceeded := quotaError("tree", quota, usage, size, longest)
+	if exceeded == nil {
+		// The maximum length of a value is part of the quota of each tree
+		exceeded = quotaError("treeservice", state.config.quota, &state.total, size, 0)
+	}
+	if exceeded == nil {
+		return true
+	}
+	loggerOf(context.Message()).Infof("Treeservice rejects %s on tree %d, it would exceed %s %s %d with %d",
+		messages.TypeOf(context.Message()),
+		treeID,
+		exceeded.Scope,
+		exceeded.Limit,
+		exceeded.Max,
+		exceeded.Requested,
+	)
+	state.respond(context, exceeded)
+	return false
+}
+
+// Responds with an InvalidRequestError and returns false if the tree holds more items than a traversal may return.
+// The tree collects all items before it responds, and so do the REST gateway and the gRPC stream, so the limit
+// bounds the memory a traversal takes. Ranges return parts of bigger trees.
+func (state *treeServiceActor) traversable(context actor.Context, treeID int64) bool {
+	limit, items := state.config.maxTraverseItems, state.usages[treeID].reported.Items
+	if limit <= 0 || items <= limit {
+		return true
+	}
+	loggerOf(context.Message()).Infof("Treeservice rejects traversal of tree %d with %d items", treeID, items)
+	state.respond(context, &messages.InvalidRequestError{
+		Reason: fmt.Sprintf("tree holds %d items, traversals return at most %d, use ranges", items, limit),
+	})
+	return false
+}
+
+// Reserves room for a forwarded request in the usage of its tree and of the treeservice.
+func (state *treeServiceActor) reserve(treeID int64, requestID string, size messages.SizeDelta) {
+	usage, exists := state.usages[treeID]
+	if !exists || size.Items == 0 && size.Bytes == 0 {
+		return
+	}
+	usage.pending = addSizes(usage.pending, size, 1)
+	state.total.pending = addSizes(state.total.pending, size, 1)
+	r, exists := state.reservations[requestID]
+	if !exists {
+		r = &reservation{treeID: treeID}
+		state.reservations[requestID] = r
+	}
+	r.remaining = addSizes(r.remaining, size, 1)
+	r.inFlight++
+}
+
+// Releases the room rejected requests reserved or marks the reservation as responded, so it settles later.
+func (state *treeServiceActor) reservationDone(context actor.Context, done *reservationDone) {
+	r, exists := state.reservations[done.requestID]
+	if !exists {
+		return
+	}
+	r.inFlight--
+	if done.rejected {
+		state.release(done.requestID, r, done.reserved)
+		return
+	}
+	r.respondedAt = time.Now()
+	self := context.Self()
+	time.AfterFunc(reservationSettle, func() {
+		actor.EmptyRootContext.Send(self, &reservationSettled{requestID: done.requestID})
+	})
+}
+
+// Returns whether the response is an error which tells that the tree didn't apply the request. Without response
+// until the deadline the tree may still apply it.
+func rejected(response interface{}) bool {
+	_, late := response.(*messages.DeadlineExceededError)
+	return !late && resultOf(response) != "success"
+}
+
+// Releases what is left of a reservation once no request with its id is in flight and it settled.
+func (state *treeServiceActor) settle(requestID string) {
+	r, exists := state.reservations[requestID]
+	if exists && r.inFlight == 0 && time.Since(r.respondedAt) >= reservationSettle {
+		state.release(requestID, r, r.remaining)
+	}
+}
+
+// Releases the growth in size from a reservation, at most the room which remains. The reservation is dropped when
+// nothing remains and no request with its id is in flight. The room of deleted trees was released with them.
+func (state *treeServiceActor) release(requestID string, r *reservation, size messages.SizeDelta) {
+	released := messages.SizeDelta{
+		Items: clamp(size.Items, r.remaining.Items),
+		Bytes: clamp(size.Bytes, r.remaining.Bytes),
+	}
+	r.remaining = addSizes(r.remaining, released, -1)
+	if usage, exists := state.usages[r.treeID]; exists {
+		usage.pending = addSizes(usage.pending, released, -1)
+		state.total.pending = addSizes(state.total.pending, released, -1)
+	}
+	if r.inFlight == 0 && r.remaining.Items == 0 && r.remaining.Bytes == 0 {
+		delete(state.reservations, requestID)
+	}
+}
+
+// Returns n limited to the range from 0 to max.
+func clamp(n, max int64) int64 {
+	switch {
+	case n < 0:
+		return 0
+	case n > max:
+		return max
+	}
+	return n
+}
+
+// Returns a plus sign times b.
+func addSizes(a, b messages.SizeDelta, sign int64) messages.SizeDelta {
+	return messages.SizeDelta{Items: a.Items + sign*b.Items, Bytes: a.Bytes + sign*b.Bytes}
+}
+
+// Adds the change reported by the root of a tree to its usage and that of the treeservice. The room reserved for
+// the request which caused the change is released, as the change counts now.
+func (state *treeServiceActor) addSizeDelta(root *actor.PID, delta *messages.SizeDelta) {
+	for id, pid := range state.trees {
+		if root != nil && pid.Equal(root) {
+			state.usages[id].reported = addSizes(state.usages[id].reported, *delta, 1)
+			state.total.reported = addSizes(state.total.reported, *delta, 1)
+			if r, exists := state.reservations[delta.RequestId]; exists && r.treeID == id {
+				state.release(delta.RequestId, r, *delta)
+			}
+			return
+		}
+	}
+}
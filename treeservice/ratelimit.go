@@ -0,0 +1,161 @@
+package main
+
+import (
+	"math"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Requests per second and the number of requests which may arrive at once. A rate of 0 disables the limit.
+type rateLimit struct {
+	rate  float64
+	burst int
+}
+
+// Token bucket holding up to burst tokens, refilled at the rate of its limit. Each request takes a token.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit rateLimit, now time.Time) *tokenBucket {
+	return &tokenBucket{tokens: capacityOf(limit), last: now}
+}
+
+// A burst below 1 would reject every request.
+func capacityOf(limit rateLimit) float64 {
+	return math.Max(1, float64(limit.burst))
+}
+
+// Refills the bucket up to now and takes a token. Returns false and the time until the next token if it is empty.
+func (b *tokenBucket) take(limit rateLimit, now time.Time) (bool, time.Duration) {
+	b.tokens = math.Min(capacityOf(limit), b.tokens+now.Sub(b.last).Seconds()*limit.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration(math.Ceil((1 - b.tokens) / limit.rate * float64(time.Second)))
+}
+
+// Returns whether the bucket is refilled completely at now, so a new one would behave the same.
+func (b *tokenBucket) full(limit rateLimit, now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*limit.rate >= capacityOf(limit)
+}
+
+// Requests addressed to a tree.
+type credentialed interface {
+	GetCredentials() *messages.Credentials
+}
+
+// Message header in which the REST and gRPC gateways pass on the address of the client a request came from.
+const clientHeader = "client-address"
+
+// Sends the request of the client with the address to the treeservice like RequestFuture.
+func requestForClient(service *actor.PID, message interface{}, client string, timeout time.Duration) *actor.Future {
+	future := actor.NewFuture(timeout)
+	sendForClient(service, message, client, future.PID())
+	return future
+}
+
+// Returns the host of the address of a client. Only the host counts, as clients get a new port with every
+// connection and treecli binds whichever port it is given.
+func hostOf(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
+
+// Sends the request of the client with the address to the treeservice, which responds to sender.
+func sendForClient(service *actor.PID, message interface{}, client string, sender *actor.PID) {
+	actor.EmptyRootContext.Send(service, &actor.MessageEnvelope{
+		Header:  map[string]string{clientHeader: hostOf(client)},
+		Message: message,
+		Sender:  sender,
+	})
+}
+
+// Returns the host of the client of the current request: that of a remote sender or, for local senders like the
+// REST and gRPC gateways, the one they passed on. Empty if the client is unknown.
+func clientOf(context actor.Context) string {
+	sender := context.Sender()
+	if sender != nil && sender.Address != "nonhost" && sender.Address != actor.ProcessRegistry.Address {
+		return hostOf(sender.Address)
+	}
+	if header := context.MessageHeader(); header != nil {
+		return header.Get(clientHeader)
+	}
+	return ""
+}
+
+// Takes a token from the bucket of the sender's address and, if the request carries valid credentials, from
+// the bucket of its tree. Invalid credentials don't count, so they can't use up the limit of someone else's tree.
+// Responds with a RateLimitedError and returns false if a bucket is empty. Messages other than requests pass.
+func (state *treeServiceActor) withinRateLimits(context actor.Context) bool {
+	if _, request := context.Message().(messages.Deadlined); !request {
+		return true
+	}
+	now := state.received
+	var limited *messages.RateLimitedError
+	if client := clientOf(context); client != "" && state.config.clientLimit.rate > 0 {
+		bucket, exists := state.clientBuckets[client]
+		if !exists {
+			bucket = newTokenBucket(state.config.clientLimit, now)
+			state.clientBuckets[client] = bucket
+		}
+		if ok, wait := bucket.take(state.config.clientLimit, now); !ok {
+			limited = &messages.RateLimitedError{Scope: "client", Key: client, RetryAfter: millisRoundedUp(wait)}
+		}
+	}
+	if msg, ok := context.Message().(credentialed); ok && limited == nil && state.config.treeLimit.rate > 0 {
+		credentials := msg.GetCredentials()
+		if _, exists := state.trees[credentials.GetId()]; exists && state.tokens[credentials.Id] == credentials.Token {
+			bucket, exists := state.treeBuckets[credentials.Id]
+			if !exists {
+				bucket = newTokenBucket(state.config.treeLimit, now)
+				state.treeBuckets[credentials.Id] = bucket
+			}
+			if ok, wait := bucket.take(state.config.treeLimit, now); !ok {
+				limited = &messages.RateLimitedError{
+					Scope:      "tree",
+					Key:        strconv.FormatInt(credentials.Id, 10),
+					RetryAfter: millisRoundedUp(wait),
+				}
+			}
+		}
+	}
+	if limited == nil {
+		return true
+	}
+	loggerOf(context.Message()).Infof("Treeservice rejects %s, rate limit of %s %s exceeded, retry after %dms",
+		messages.TypeOf(context.Message()),
+		limited.Scope,
+		limited.Key,
+		limited.RetryAfter,
+	)
+	state.respond(context, limited)
+	return false
+}
+
+func millisRoundedUp(d time.Duration) int64 {
+	return int64((d + time.Millisecond - 1) / time.Millisecond)
+}
+
+// Drops the buckets which are full again, so clients which stopped sending don't keep theirs.
+func (state *treeServiceActor) purgeBuckets(now time.Time) {
+	for client, bucket := range state.clientBuckets {
+		if bucket.full(state.config.clientLimit, now) {
+			delete(state.clientBuckets, client)
+		}
+	}
+	for id, bucket := range state.treeBuckets {
+		if bucket.full(state.config.treeLimit, now) {
+			delete(state.treeBuckets, id)
+		}
+	}
+}
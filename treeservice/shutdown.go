@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/remote"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/tracing"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// Time remote gets for closing its connections gracefully. Clients keep theirs open, so it mostly waits in vain.
+const remoteShutdownTimeout = 2 * time.Second
+
+// Asks the treeservice to reject new requests with ShuttingDownError. It responds with drained once
+// all forwarded requests got their response.
+type drainRequest struct{}
+
+type drained struct{}
+
+// Tells the treeservice that a forwarded request got its response.
+type forwardDone struct{}
+
+// Asks the treeservice to poison its trees one after another in the order of their ids. It responds with
+// treesStopped once the last one stopped.
+type stopTreesRequest struct{}
+
+type treesStopped struct{}
+
+// Progress of the shutdown of the treeservice.
+type shutdownState struct {
+	draining    bool
+	inFlight    int
+	drainWaiter *actor.PID
+	// Ids of the trees still to stop and the root of the one stopping now
+	stopping   []int64
+	current    *actor.PID
+	stopWaiter *actor.PID
+}
+
+// Rejects the current message with ShuttingDownError and returns true if it is a request arriving while draining.
+func (state *treeServiceActor) rejectedWhileDraining(context actor.Context) bool {
+	if _, request := context.Message().(messages.Deadlined); !request || !state.shutdown.draining {
+		return false
+	}
+	loggerOf(context.Message()).Infof("Treeservice rejects %s, it is shutting down", messages.TypeOf(context.Message()))
+	state.respond(context, &messages.ShuttingDownError{})
+	return true
+}
+
+// Tells the one waiting for the drain that no forwarded request is in flight anymore.
+func (state *treeServiceActor) checkDrained(context actor.Context) {
+	if state.shutdown.draining && state.shutdown.inFlight == 0 && state.shutdown.drainWaiter != nil {
+		context.Send(state.shutdown.drainWaiter, &drained{})
+		state.shutdown.drainWaiter = nil
+	}
+}
+
+// Starts stopping the trees in the order of their ids.
+func (state *treeServiceActor) stopTrees(context actor.Context) {
+	ids := make([]int64, 0, len(state.trees))
+	for id := range state.trees {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	state.shutdown.stopping = ids
+	state.shutdown.stopWaiter = context.Sender()
+	state.stopNextTree(context)
+}
+
+// Poisons the next tree, so it handles the messages it received before stopping. Skips trees deleted meanwhile.
+// Tells the one waiting when all trees stopped.
+func (state *treeServiceActor) stopNextTree(context actor.Context) {
+	for len(state.shutdown.stopping) > 0 {
+		id := state.shutdown.stopping[0]
+		state.shutdown.stopping = state.shutdown.stopping[1:]
+		if root, exists := state.trees[id]; exists {
+			loggerOf(context.Message()).Infof("Treeservice stops tree %d", id)
+			state.shutdown.current = root
+			context.Poison(root)
+			return
+		}
+	}
+	state.shutdown.current = nil
+	if state.shutdown.stopWaiter != nil {
+		context.Send(state.shutdown.stopWaiter, &treesStopped{})
+		state.shutdown.stopWaiter = nil
+	}
+}
+
+// Continues with the next tree if the terminated actor is the tree stopping now.
+func (state *treeServiceActor) treeTerminated(context actor.Context, who *actor.PID) {
+	if state.shutdown.current != nil && state.shutdown.current.Equal(who) {
+		state.stopNextTree(context)
+	}
+}
+
+// Returns the time left until the deadline, at least a millisecond so futures don't wait without timeout.
+func remaining(deadline time.Time) time.Duration {
+	if left := time.Until(deadline); left > time.Millisecond {
+		return left
+	}
+	return time.Millisecond
+}
+
+// Blocks until SIGINT or SIGTERM arrives and shuts the treeservice down: the gateways stop accepting connections,
+// the treeservice rejects new requests and waits for the responses to those in flight, clones included. Then the
+// trees are stopped one after another, remote and the treeservice actor shut down and the remaining spans exported.
+// Steps still running when the grace period is over are cut short, a second signal exits immediately.
+func shutdownOnSignal(service *actor.PID, gateway *http.Server, rpc *grpc.Server, grace time.Duration) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	received := <-signals
+	logrus.Infof("Treeservice received %s, shutting down within %s", received, grace)
+	go func() {
+		logrus.Warnf("Treeservice received %s again, exiting without shutting down", <-signals)
+		os.Exit(1)
+	}()
+	deadline := time.Now().Add(grace)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	var servers sync.WaitGroup
+	if gateway != nil {
+		servers.Add(1)
+		go func() {
+			defer servers.Done()
+			if err := gateway.Shutdown(ctx); err != nil {
+				logrus.Warnf("REST gateway didn't shut down in time: %v", err)
+			}
+		}()
+	}
+	if rpc != nil {
+		servers.Add(1)
+		go func() {
+			defer servers.Done()
+			stopped := make(chan struct{})
+			go func() {
+				rpc.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				logrus.Warn("gRPC TreeService didn't shut down in time")
+				rpc.Stop()
+			}
+		}()
+	}
+	root := actor.EmptyRootContext
+	if _, err := root.RequestFuture(service, &drainRequest{}, remaining(deadline)).Result(); err != nil {
+		logrus.Warnf("Treeservice shuts down with requests in flight: %v", err)
+	} else {
+		logrus.Info("Treeservice answered all requests in flight")
+	}
+	servers.Wait()
+
+	if _, err := root.RequestFuture(service, &stopTreesRequest{}, remaining(deadline)).Result(); err != nil {
+		logrus.Warnf("Treeservice didn't stop all trees in time: %v", err)
+	}
+	shutdownRemote(deadline)
+	if err := root.PoisonFuture(service).Wait(); err != nil {
+		logrus.Warnf("Treeservice actor didn't stop: %v", err)
+	}
+	if err := tracing.Flush(remaining(deadline)); err != nil {
+		logrus.Warnf("Failed to export the remaining spans: %v", err)
+	}
+	logrus.Info("Treeservice stopped")
+}
+
+// Shuts remote down gracefully, so messages still queued for clients are sent, and kills it if that doesn't
+// finish within remoteShutdownTimeout or the grace period.
+func shutdownRemote(deadline time.Time) {
+	timeout := remaining(deadline)
+	if timeout > remoteShutdownTimeout {
+		timeout = remoteShutdownTimeout
+	}
+	stopped := make(chan struct{})
+	go func() {
+		remote.Shutdown(true)
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		remote.Shutdown(false)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/ob-vss-ss19/blatt-3-forever_alone/messages"
+)
+
+// Returns why the request is invalid, e.g. which required field it lacks, or an empty string if it is valid.
+// The treeservice and the nodes rely on these fields, so requests without them must not reach them.
+func invalidReason(message interface{}) string {
+	if msg, ok := message.(credentialed); ok && msg.GetCredentials() == nil {
+		return "credentials missing"
+	}
+	switch msg := message.(type) {
+	case *messages.CreateTreeRequest:
+		// Leafs with room for no item would split forever
+		if msg.MaxSize < 1 {
+			return "maxSize must be at least 1"
+		}
+	case *messages.InsertRequest:
+		if msg.Item == nil {
+			return "item missing"
+		}
+	case *messages.InsertBatchRequest:
+		for i, item := range msg.Items {
+			if item == nil {
+				return fmt.Sprintf("items[%d] missing", i)
+			}
+		}
+	case *messages.StageTxRequest:
+		if msg.Operation == nil {
+			return "operation missing"
+		}
+		if msg.Operation.Item == nil {
+			return "operation.item missing"
+		}
+	}
+	return ""
+}
+
+// Responds with an InvalidRequestError and returns false if the current request is invalid.
+func (state *treeServiceActor) wellFormed(context actor.Context) bool {
+	reason := invalidReason(context.Message())
+	if reason == "" {
+		return true
+	}
+	loggerOf(context.Message()).Warnf("Treeservice rejects %s, %s", messages.TypeOf(context.Message()), reason)
+	state.respond(context, &messages.InvalidRequestError{Reason: reason})
+	return false
+}